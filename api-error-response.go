@@ -74,8 +74,16 @@ func httpRespToErrorResponse(resp *http.Response) error {
 	}
 
 	defer closeResponse(resp)
+	// Error responses are gzip encoded too, when requested.
+	r, err := responseReader(resp)
+	if err != nil {
+		return ErrorResponse{
+			Code:    resp.Status,
+			Message: fmt.Sprintf("Failed to read server response: %s.", err),
+		}
+	}
 	// Limit to 100K
-	body, err := io.ReadAll(io.LimitReader(resp.Body, 100<<10))
+	body, err := io.ReadAll(io.LimitReader(r, 100<<10))
 	if err != nil {
 		return ErrorResponse{
 			Code:    resp.Status,
@@ -20,13 +20,33 @@
 package madmin
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"maps"
+	"math"
+	"math/bits"
+	"net"
 	"net/http"
 	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
 	"github.com/shirou/gopsutil/v4/host"
 )
 
@@ -49,6 +69,19 @@ const (
 	// Add your own backend.
 )
 
+// String returns the name of the backend type.
+func (b BackendType) String() string {
+	switch b {
+	case FS:
+		return "FS"
+	case Erasure:
+		return "Erasure"
+	case Gateway:
+		return "Gateway"
+	}
+	return "Unknown"
+}
+
 // ItemState - represents the status of any item in offline,init,online state
 type ItemState string
 
@@ -68,6 +101,17 @@ const (
 	ItemCordoned = ItemState("cordoned")
 )
 
+// IsValid returns true if s is one of the item states reported by the
+// server.
+func (s ItemState) IsValid() bool {
+	switch s {
+	case ItemOffline, ItemInitializing, ItemOnline, ItemRestarting,
+		ItemDraining, ItemCordoned:
+		return true
+	}
+	return false
+}
+
 // StorageInfo - represents total capacity of underlying storage.
 type StorageInfo struct {
 	Disks []Disk
@@ -126,249 +170,2641 @@ func (d1 BackendDisks) Merge(d2 BackendDisks) BackendDisks {
 	return merged
 }
 
-// StorageInfo - Connect to a minio server and call Storage Info Management API
-// to fetch server's information represented by StorageInfo structure
-func (adm *AdminClient) StorageInfo(ctx context.Context) (StorageInfo, error) {
-	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{relPath: adminAPIPrefix + "/storageinfo"})
-	defer closeResponse(resp)
-	if err != nil {
-		return StorageInfo{}, err
-	}
-
-	// Check response http status code
-	if resp.StatusCode != http.StatusOK {
-		return StorageInfo{}, httpRespToErrorResponse(resp)
+// Diff - Returns the change in disks per endpoint from d1 to d2,
+// endpoints missing from either map are counted as having no disks.
+func (d1 BackendDisks) Diff(d2 BackendDisks) map[string]int {
+	diff := make(map[string]int, len(d1))
+	for endpoint, n := range d1 {
+		diff[endpoint] = d2[endpoint] - n
 	}
-
-	// Unmarshal the server's json response
-	var storageInfo StorageInfo
-	if err = json.NewDecoder(resp.Body).Decode(&storageInfo); err != nil {
-		return StorageInfo{}, err
+	for endpoint, n := range d2 {
+		if _, ok := d1[endpoint]; !ok {
+			diff[endpoint] = n
+		}
 	}
-
-	return storageInfo, nil
+	return diff
 }
 
-// BucketUsageInfo - bucket usage info provides
-// - total size of the bucket
-// - total objects in a bucket
-// - object size histogram per bucket
-type BucketUsageInfo struct {
-	Size                    uint64 `json:"size"`
-	ReplicationPendingSize  uint64 `json:"objectsPendingReplicationTotalSize"`
-	ReplicationFailedSize   uint64 `json:"objectsFailedReplicationTotalSize"`
-	ReplicatedSize          uint64 `json:"objectsReplicatedTotalSize"`
-	ReplicaSize             uint64 `json:"objectReplicaTotalSize"`
-	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
-	ReplicationFailedCount  uint64 `json:"objectsFailedReplicationCount"`
+// MergeStorageInfo combines several StorageInfo responses, for example
+// from different deployments, into one. Disks and per pool values are
+// concatenated in order, and the backend type is kept only if all the
+// responses agree on it, otherwise it is set to Unknown.
+func MergeStorageInfo(infos ...StorageInfo) StorageInfo {
+	var merged StorageInfo
+	for i, info := range infos {
+		merged.Disks = append(merged.Disks, info.Disks...)
 
-	VersionsCount           uint64            `json:"versionsCount"`
-	ObjectsCount            uint64            `json:"objectsCount"`
-	DeleteMarkersCount      uint64            `json:"deleteMarkersCount"`
-	ObjectSizesHistogram    map[string]uint64 `json:"objectsSizesHistogram"`
-	ObjectVersionsHistogram map[string]uint64 `json:"objectsVersionsHistogram"`
+		b := info.Backend
+		if i == 0 {
+			merged.Backend.Type = b.Type
+			merged.Backend.GatewayOnline = b.GatewayOnline
+		} else {
+			if merged.Backend.Type != b.Type {
+				merged.Backend.Type = Unknown
+			}
+			merged.Backend.GatewayOnline = merged.Backend.GatewayOnline && b.GatewayOnline
+		}
+		merged.Backend.OnlineDisks = addBackendDisks(merged.Backend.OnlineDisks, b.OnlineDisks)
+		merged.Backend.OfflineDisks = addBackendDisks(merged.Backend.OfflineDisks, b.OfflineDisks)
+		merged.Backend.StandardSCData = append(merged.Backend.StandardSCData, b.StandardSCData...)
+		merged.Backend.StandardSCParities = append(merged.Backend.StandardSCParities, b.StandardSCParities...)
+		merged.Backend.RRSCData = append(merged.Backend.RRSCData, b.RRSCData...)
+		merged.Backend.RRSCParities = append(merged.Backend.RRSCParities, b.RRSCParities...)
+		merged.Backend.TotalSets = append(merged.Backend.TotalSets, b.TotalSets...)
+		merged.Backend.DrivesPerSet = append(merged.Backend.DrivesPerSet, b.DrivesPerSet...)
+	}
+	return merged
 }
 
-// DataUsageInfo represents data usage stats of the underlying Object API
-type DataUsageInfo struct {
-	// LastUpdate is the timestamp of when the data usage info was last updated.
-	// This does not indicate a full scan.
-	LastUpdate time.Time `json:"lastUpdate"`
-
-	// Objects total count across all buckets
-	ObjectsTotalCount uint64 `json:"objectsCount"`
-
-	// Objects total size across all buckets
-	ObjectsTotalSize uint64 `json:"objectsTotalSize"`
-
-	// Total Size for objects that have not yet been replicated
-	ReplicationPendingSize uint64 `json:"objectsPendingReplicationTotalSize"`
+// addBackendDisks adds the disks of src to dst, including the endpoints
+// not yet in dst, which BackendDisks.Merge drops.
+func addBackendDisks(dst, src BackendDisks) BackendDisks {
+	if dst == nil {
+		dst = make(BackendDisks, len(src))
+	}
+	for endpoint, count := range src {
+		dst[endpoint] += count
+	}
+	return dst
+}
 
-	// Total size for objects that have witness one or more failures and will be retried
-	ReplicationFailedSize uint64 `json:"objectsFailedReplicationTotalSize"`
+// parityTolerance returns the number of drives an erasure set of
+// setDriveCount drives with the given parity may lose while still
+// retaining write quorum.
+func parityTolerance(parity, setDriveCount int) int {
+	if parity <= 0 || setDriveCount <= 0 {
+		return 0
+	}
+	if 2*parity == setDriveCount {
+		// Write quorum is data+1 when data and parity are equal.
+		return parity - 1
+	}
+	return parity
+}
 
-	// Total size for objects that have been replicated to destination
-	ReplicatedSize uint64 `json:"objectsReplicatedTotalSize"`
+// ParitySafetyMargin returns the fraction of drives, between 0 and 1,
+// that may fail in every erasure set of the most constrained pool before
+// write quorum is lost. Returns 0 for non-erasure backends.
+func (s StorageInfo) ParitySafetyMargin() float64 {
+	b := s.Backend
+	if b.Type != Erasure {
+		return 0
+	}
+	margin := -1.0
+	for pool, drives := range b.DrivesPerSet {
+		if drives <= 0 || pool >= len(b.StandardSCParities) {
+			continue
+		}
+		m := float64(parityTolerance(b.StandardSCParities[pool], drives)) / float64(drives)
+		if margin < 0 || m < margin {
+			margin = m
+		}
+	}
+	if margin < 0 {
+		return 0
+	}
+	return margin
+}
 
-	// Total size for objects that are replicas
-	ReplicaSize uint64 `json:"objectsReplicaTotalSize"`
+// DriveSummary holds drive counts and space totals across a StorageInfo.
+//
+//msgp:ignore DriveSummary
+type DriveSummary struct {
+	Online   int // Drives in the ok state.
+	Offline  int // Drives reporting any other state.
+	Unknown  int // Drives without a reported state.
+	Healing  int
+	Scanning int
+	Root     int
 
-	// Total number of objects pending replication
-	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
+	// Space summed across non-root drives.
+	TotalSpace     uint64
+	UsedSpace      uint64
+	AvailableSpace uint64
+}
 
-	// Total number of objects that failed replication
-	ReplicationFailedCount uint64 `json:"objectsFailedReplicationCount"`
+// DriveSummary returns drive state counts and space totals across all
+// disks. Drives with an empty state are counted as unknown, which
+// indicates a partial response.
+func (s StorageInfo) DriveSummary() (summary DriveSummary) {
+	for _, disk := range s.Disks {
+		switch disk.State {
+		case DriveStateOk:
+			summary.Online++
+		case "":
+			summary.Unknown++
+		default:
+			summary.Offline++
+		}
+		if disk.Healing {
+			summary.Healing++
+		}
+		if disk.Scanning {
+			summary.Scanning++
+		}
+		if disk.RootDisk {
+			summary.Root++
+			continue
+		}
+		summary.TotalSpace += disk.TotalSpace
+		summary.UsedSpace += disk.UsedSpace
+		summary.AvailableSpace += disk.AvailableSpace
+	}
+	return summary
+}
 
-	// Total number of buckets in this cluster
-	BucketsCount uint64 `json:"bucketsCount"`
+// WriteAmplification returns the number of raw bytes written per logical
+// byte for the standard storage class, (data+parity)/data. Multi-pool
+// deployments return the average across pools weighted by pool capacity.
+// Non-erasure backends return 1.
+func (s StorageInfo) WriteAmplification() float64 {
+	b := s.Backend
+	if b.Type != Erasure {
+		return 1
+	}
+	poolCapacity := make(map[int]uint64)
+	for _, disk := range s.Disks {
+		poolCapacity[disk.PoolIndex] += disk.TotalSpace
+	}
 
-	// Buckets usage info provides following information across all buckets
-	// - total size of the bucket
-	// - total objects in a bucket
-	// - object size histogram per bucket
-	BucketsUsage map[string]BucketUsageInfo `json:"bucketsUsageInfo"`
+	var sum, weights float64
+	for pool, drives := range b.DrivesPerSet {
+		var data int
+		switch {
+		case pool < len(b.StandardSCData):
+			data = b.StandardSCData[pool]
+		case pool < len(b.StandardSCParities):
+			data = drives - b.StandardSCParities[pool]
+		}
+		if data <= 0 || drives <= 0 {
+			continue
+		}
+		weight := float64(poolCapacity[pool])
+		if len(s.Disks) == 0 {
+			weight = 1
+		}
+		sum += weight * float64(drives) / float64(data)
+		weights += weight
+	}
+	if weights == 0 {
+		return 1
+	}
+	return sum / weights
+}
 
-	// TierStats holds per-tier stats like bytes tiered, etc.
-	TierStats map[string]TierStats `json:"tierStats"`
+// FillPercent returns the used space of all non-root disks as a
+// percentage of their total space, or 0 if the total space is unknown.
+func (s StorageInfo) FillPercent() float64 {
+	var total, used uint64
+	for _, disk := range s.Disks {
+		if disk.RootDisk {
+			continue
+		}
+		total += disk.TotalSpace
+		used += disk.UsedSpace
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(used) * 100 / float64(total)
+}
 
-	// Server capacity related data
-	TotalCapacity     uint64 `json:"capacity"`
-	TotalFreeCapacity uint64 `json:"freeCapacity"`
-	TotalUsedCapacity uint64 `json:"usedCapacity"`
+// Endpoints returns the sorted, unique endpoints of the disks, skipping
+// empty ones.
+func (s StorageInfo) Endpoints() []string {
+	return s.endpoints(false)
 }
 
-// DataUsageInfo - returns data usage of the current object API
-func (adm *AdminClient) DataUsageInfo(ctx context.Context) (DataUsageInfo, error) {
-	values := make(url.Values)
-	values.Set("capacity", "true") // We can make this configurable in future but for now its fine.
+// LocalEndpoints is like Endpoints, limited to the local disks.
+func (s StorageInfo) LocalEndpoints() []string {
+	return s.endpoints(true)
+}
 
-	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
-		relPath:     adminAPIPrefix + "/datausageinfo",
-		queryValues: values,
-	})
-	defer closeResponse(resp)
-	if err != nil {
-		return DataUsageInfo{}, err
+func (s StorageInfo) endpoints(localOnly bool) []string {
+	set := make(map[string]struct{}, len(s.Disks))
+	for _, disk := range s.Disks {
+		if disk.Endpoint == "" || (localOnly && !disk.Local) {
+			continue
+		}
+		set[disk.Endpoint] = struct{}{}
 	}
+	return slices.Sorted(maps.Keys(set))
+}
 
-	// Check response http status code
-	if resp.StatusCode != http.StatusOK {
-		return DataUsageInfo{}, httpRespToErrorResponse(resp)
-	}
+// HasPerPoolInfo returns true if the backend reports the number of
+// erasure sets and drives per set of each pool, which older servers do
+// not send.
+func (s StorageInfo) HasPerPoolInfo() bool {
+	return len(s.Backend.TotalSets) > 0 && len(s.Backend.DrivesPerSet) > 0
+}
 
-	// Unmarshal the server's json response
-	var dataUsageInfo DataUsageInfo
-	if err = json.NewDecoder(resp.Body).Decode(&dataUsageInfo); err != nil {
-		return DataUsageInfo{}, err
+// AggregateDiskMetrics merges the last minute API metrics of all disks
+// by API name, so that the average durations are weighted by the count
+// of calls on each disk. Disks without metrics are skipped.
+func (s StorageInfo) AggregateDiskMetrics() map[string]TimedAction {
+	actions := make(map[string]TimedAction)
+	for _, disk := range s.Disks {
+		if disk.Metrics == nil {
+			continue
+		}
+		for api, action := range disk.Metrics.LastMinute {
+			merged := actions[api]
+			merged.Merge(action)
+			actions[api] = merged
+		}
 	}
+	return actions
+}
 
-	return dataUsageInfo, nil
+// PoolCapacity holds the space of all disks of a pool.
+//
+//msgp:ignore PoolCapacity
+type PoolCapacity struct {
+	TotalSpace     uint64
+	UsedSpace      uint64
+	AvailableSpace uint64
 }
 
-// ErasureSetInfo provides information per erasure set
-type ErasureSetInfo struct {
-	ID                 int      `json:"id"`
-	RawUsage           uint64   `json:"rawUsage"`
-	RawCapacity        uint64   `json:"rawCapacity"`
-	Usage              uint64   `json:"usage"`
-	ObjectsCount       uint64   `json:"objectsCount"`
-	VersionsCount      uint64   `json:"versionsCount"`
-	DeleteMarkersCount uint64   `json:"deleteMarkersCount"`
-	HealDisks          int      `json:"healDisks"`
-	OnlineDisks        int      `json:"onlineDisks,omitempty"`
-	OfflineDisks       int      `json:"offlineDisks,omitempty"`
-	Nodes              []string `json:"nodes,omitempty"`
+// CapacityByPool returns the space of the disks of each pool, keyed by
+// pool index. Disks not yet assigned to a pool are grouped under -1.
+// Root disks are skipped.
+func (s StorageInfo) CapacityByPool() map[int]PoolCapacity {
+	pools := make(map[int]PoolCapacity)
+	for _, disk := range s.Disks {
+		if disk.RootDisk {
+			continue
+		}
+		pool := disk.PoolIndex
+		if pool < 0 {
+			pool = -1
+		}
+		pc := pools[pool]
+		pc.TotalSpace += disk.TotalSpace
+		pc.UsedSpace += disk.UsedSpace
+		pc.AvailableSpace += disk.AvailableSpace
+		pools[pool] = pc
+	}
+	return pools
 }
 
-// InfoMessage container to hold server admin related information.
-type InfoMessage struct {
-	Mode             string             `json:"mode,omitempty"`
-	Domain           []string           `json:"domain,omitempty"`
-	Region           string             `json:"region,omitempty"`
-	SQSARN           []string           `json:"sqsARN,omitempty"`
-	DeploymentID     string             `json:"deploymentID,omitempty"`
-	ObjectNamingMode string             `json:"objectNamingMode,omitempty"`
-	Buckets          Buckets            `json:"buckets,omitempty"`
-	Objects          Objects            `json:"objects,omitempty"`
-	Versions         Versions           `json:"versions,omitempty"`
-	DeleteMarkers    DeleteMarkers      `json:"deletemarkers,omitempty"`
-	Usage            Usage              `json:"usage,omitempty"`
-	Services         Services           `json:"services,omitempty"`
-	Backend          ErasureBackend     `json:"backend,omitempty"`
-	Servers          []ServerProperties `json:"servers,omitempty"`
+// DefaultFreeSpaceReservePct is the percentage of free space MinIO keeps
+// in reserve by default: writes are rejected once a drive is more than
+// 99% full.
+const DefaultFreeSpaceReservePct = 1.0
 
-	Pools map[int]map[int]ErasureSetInfo `json:"pools,omitempty"`
+// UsableFreeWithReservation returns the free space available for object
+// data, after accounting for erasure parity and keeping reservePct
+// percent (0-100) of it in reserve. Root drives are ignored. Use
+// DefaultFreeSpaceReservePct to match the server behavior.
+func (s StorageInfo) UsableFreeWithReservation(reservePct float64) uint64 {
+	var free float64
+	for _, disk := range s.Disks {
+		if disk.RootDisk {
+			continue
+		}
+		ratio, ok := s.dataRatio(disk.PoolIndex)
+		if !ok {
+			continue
+		}
+		free += float64(disk.AvailableSpace) * ratio
+	}
+	reservePct = min(max(reservePct, 0), 100)
+	return uint64(free * (1 - reservePct/100))
 }
 
-func (info InfoMessage) BackendType() BackendType {
-	// MinIO server type default
-	switch info.Backend.Type {
-	case "Erasure":
-		return Erasure
-	case "FS":
-		return FS
-	default:
-		return Unknown
+// dataRatio returns the fraction of the space of a disk in pool that
+// holds object data, data/(data+parity) for the standard storage class
+// of erasure backends and 1 otherwise. ok is false when the erasure
+// layout of the pool is unknown.
+func (s StorageInfo) dataRatio(pool int) (ratio float64, ok bool) {
+	if s.Backend.Type != Erasure {
+		return 1, true
+	}
+	if pool < 0 || pool >= len(s.Backend.StandardSCData) || pool >= len(s.Backend.StandardSCParities) {
+		return 0, false
+	}
+	data, parity := s.Backend.StandardSCData[pool], s.Backend.StandardSCParities[pool]
+	if data+parity <= 0 {
+		return 0, false
 	}
+	return float64(data) / float64(data+parity), true
 }
 
-func (info InfoMessage) StandardParity() int {
-	switch info.BackendType() {
-	case Erasure:
-		return info.Backend.StandardSCParity
-	default:
-		return -1
+// FilterDisks returns the disks for which pred returns true.
+func (s StorageInfo) FilterDisks(pred func(Disk) bool) []Disk {
+	var disks []Disk
+	for _, disk := range s.Disks {
+		if pred(disk) {
+			disks = append(disks, disk)
+		}
 	}
+	return disks
 }
 
-// Services contains different services information
-type Services struct {
-	KMS           KMS                           `json:"kms,omitempty"` // deprecated july 2023
-	KMSStatus     []KMS                         `json:"kmsStatus,omitempty"`
-	LDAP          LDAP                          `json:"ldap,omitempty"`
-	LDAPStatus    map[string]LDAP               `json:"ldapStatus,omitempty"`
-	Logger        []Logger                      `json:"logger,omitempty"`
-	Audit         []Audit                       `json:"audit,omitempty"`
-	Notifications []map[string][]TargetIDStatus `json:"notifications,omitempty"`
+// UnplacedOnlineDisks returns the disks reporting an ok state without
+// having been assigned a pool, set or disk index, which is inconsistent.
+func (s StorageInfo) UnplacedOnlineDisks() []Disk {
+	return s.FilterDisks(func(d Disk) bool {
+		return d.State == DriveStateOk && (d.PoolIndex < 0 || d.SetIndex < 0 || d.DiskIndex < 0)
+	})
 }
 
-// ListNotificationARNs return a list of configured notification ARNs
-func (s Services) ListNotificationARNs() (arns []ARN) {
-	for _, notify := range s.Notifications {
-		for targetType, targetStatuses := range notify {
-			for _, targetStatus := range targetStatuses {
-				for targetID := range targetStatus {
-					arns = append(arns, ARN{
-						Type:     "sqs",
-						ID:       targetID,
-						Resource: targetType,
-					})
-				}
-			}
+// DataVsMetadataDrives returns the number of data drives and dedicated
+// metadata drives. Deployments without dedicated metadata drives report
+// all drives as data drives.
+func (s StorageInfo) DataVsMetadataDrives() (data, metadata int) {
+	for _, disk := range s.Disks {
+		if disk.Metadata {
+			metadata++
+		} else {
+			data++
 		}
 	}
-	return arns
+	return data, metadata
 }
 
-// Buckets contains the number of buckets
-type Buckets struct {
-	Count uint64 `json:"count"`
-	Error string `json:"error,omitempty"`
+// AvgObjectsPerDrive returns the objects count reported by im divided by
+// the number of online data drives, excluding dedicated metadata drives.
+// Returns 0 if no data drive is online.
+func (s StorageInfo) AvgObjectsPerDrive(im InfoMessage) float64 {
+	var drives int
+	for _, disk := range s.Disks {
+		if disk.State == DriveStateOk && !disk.Metadata {
+			drives++
+		}
+	}
+	if drives == 0 {
+		return 0
+	}
+	return float64(im.Objects.Count) / float64(drives)
 }
 
-// Objects contains the number of objects
-type Objects struct {
-	Count uint64 `json:"count"`
-	Error string `json:"error,omitempty"`
+// DriveStateDistribution returns the fraction of drives in each drive
+// state, keyed by Disk.State, such as DriveStateOk or DriveStateOffline.
+// As a drive has a single state, these fractions sum to 1. Healing and
+// scanning drives are in one of these states too, so they are returned
+// separately as the fraction of drives healing and being scanned.
+func (s StorageInfo) DriveStateDistribution() (states map[string]float64, healing, scanning float64) {
+	states = make(map[string]float64)
+	if len(s.Disks) == 0 {
+		return states, 0, 0
+	}
+	counts := make(map[string]int)
+	var healCount, scanCount int
+	for _, disk := range s.Disks {
+		counts[disk.State]++
+		if disk.Healing {
+			healCount++
+		}
+		if disk.Scanning {
+			scanCount++
+		}
+	}
+	total := float64(len(s.Disks))
+	for state, n := range counts {
+		states[state] = float64(n) / total
+	}
+	return states, float64(healCount) / total, float64(scanCount) / total
 }
 
-// Versions contains the number of versions
-type Versions struct {
-	Count uint64 `json:"count"`
-	Error string `json:"error,omitempty"`
+// clusterCache merges the cache stats of all drives with caching enabled.
+func (s StorageInfo) clusterCache() (stats CacheStats) {
+	for _, disk := range s.Disks {
+		stats.Merge(disk.Cache)
+	}
+	return stats
 }
 
-// DeleteMarkers contains the number of delete markers
-type DeleteMarkers struct {
-	Count uint64 `json:"count"`
-	Error string `json:"error,omitempty"`
+// ClusterCacheHitRatio returns the ratio, between 0 and 1, of cache hits
+// to lookups across all drives with caching enabled. Returns 0 if no
+// drive reports cache stats. Unlike CacheStats.HitRatio, the result is
+// not a percentage.
+func (s StorageInfo) ClusterCacheHitRatio() float64 {
+	return s.clusterCache().HitRatio() / 100
 }
 
-// Usage contains the total size used
-type Usage struct {
-	Size  uint64 `json:"size"`
-	Error string `json:"error,omitempty"`
+// ClusterCacheUsage returns the cache capacity and usage summed across
+// all drives with caching enabled.
+func (s StorageInfo) ClusterCacheUsage() (capacity, used int64) {
+	stats := s.clusterCache()
+	return stats.Capacity, stats.Used
 }
 
-// TierStats contains per-tier statistics like total size, number of
-// objects/versions transitioned, etc.
-type TierStats struct {
-	TotalSize   uint64 `json:"totalSize"`
-	NumVersions int    `json:"numVersions"`
-	NumObjects  int    `json:"numObjects"`
-}
+// AlertSeverity is the severity of an alert.
+type AlertSeverity string
 
-// KMS contains KMS status information
+const (
+	// AlertWarning indicates a warning threshold was reached.
+	AlertWarning = AlertSeverity("warning")
+	// AlertCritical indicates a critical threshold was reached.
+	AlertCritical = AlertSeverity("critical")
+)
+
+// InodeAlert reports a disk whose inode usage reached a threshold.
+//
+//msgp:ignore InodeAlert
+type InodeAlert struct {
+	Endpoint string
+	Path     string
+	Ratio    float64 // Used inodes over all inodes, between 0 and 1.
+	Severity AlertSeverity
+}
+
+// InodeAlerts returns an alert for each disk whose inode usage, in
+// percent, is at or above warnPct or critPct. Disks not reporting inodes
+// are skipped.
+func (s StorageInfo) InodeAlerts(warnPct, critPct float64) []InodeAlert {
+	var alerts []InodeAlert
+	for _, disk := range s.Disks {
+		if disk.UsedInodes+disk.FreeInodes == 0 {
+			continue
+		}
+		used := disk.InodeUsedPercent()
+		var severity AlertSeverity
+		switch {
+		case used >= critPct:
+			severity = AlertCritical
+		case used >= warnPct:
+			severity = AlertWarning
+		default:
+			continue
+		}
+		alerts = append(alerts, InodeAlert{
+			Endpoint: disk.Endpoint,
+			Path:     disk.DrivePath,
+			Ratio:    used / 100,
+			Severity: severity,
+		})
+	}
+	return alerts
+}
+
+// Utilization thresholds used by ClusterSaturated.
+const (
+	// DiskSaturationThreshold is the utilization, in percent, at or above
+	// which a disk is considered saturated.
+	DiskSaturationThreshold = 99.0
+
+	// ClusterSaturationFraction is the fraction of disks reporting metrics
+	// that must be saturated for the cluster to be considered saturated.
+	ClusterSaturationFraction = 0.25
+)
+
+// SaturatedDisks returns the disks whose utilization, in percent, is at
+// or above threshold. Disks without metrics are excluded.
+func (s StorageInfo) SaturatedDisks(threshold float64) []Disk {
+	return s.FilterDisks(func(disk Disk) bool {
+		return disk.Metrics != nil && disk.Utilization >= threshold
+	})
+}
+
+// ClusterSaturated returns true when at least ClusterSaturationFraction
+// of the disks reporting metrics are saturated, as per
+// DiskSaturationThreshold.
+func (s StorageInfo) ClusterSaturated() bool {
+	withMetrics := len(s.FilterDisks(func(disk Disk) bool {
+		return disk.Metrics != nil
+	}))
+	if withMetrics == 0 {
+		return false
+	}
+	saturated := len(s.SaturatedDisks(DiskSaturationThreshold))
+	return float64(saturated)/float64(withMetrics) >= ClusterSaturationFraction
+}
+
+// WriteDisksCSV writes one CSV row per disk to w, preceded by a header
+// row. The throughput, latency and utilization columns are left empty for
+// disks that did not report metrics.
+func (s StorageInfo) WriteDisksCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{
+		"endpoint", "state",
+		"total_space", "used_space", "available_space",
+		"read_throughput", "write_throughput",
+		"read_latency", "write_latency", "utilization",
+		"pool_index", "set_index", "disk_index",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	for _, disk := range s.Disks {
+		metrics := make([]string, 5)
+		if disk.Metrics != nil {
+			metrics = []string{
+				formatFloat(disk.ReadThroughput), formatFloat(disk.WriteThroughPut),
+				formatFloat(disk.ReadLatency), formatFloat(disk.WriteLatency),
+				formatFloat(disk.Utilization),
+			}
+		}
+		row := []string{
+			disk.Endpoint, disk.State,
+			strconv.FormatUint(disk.TotalSpace, 10),
+			strconv.FormatUint(disk.UsedSpace, 10),
+			strconv.FormatUint(disk.AvailableSpace, 10),
+		}
+		row = append(row, metrics...)
+		row = append(row,
+			strconv.Itoa(disk.PoolIndex),
+			strconv.Itoa(disk.SetIndex),
+			strconv.Itoa(disk.DiskIndex),
+		)
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// EstimateIOPSHeadroom returns a rough estimate, per disk endpoint, of the
+// additional operations per second each disk could serve, based on the
+// API calls observed between two snapshots taken elapsed apart and the
+// current utilization: (1 - utilization) * IOPS / utilization.
+// Disks without metrics in both snapshots or without utilization are
+// skipped.
+func EstimateIOPSHeadroom(prev, cur StorageInfo, elapsed time.Duration) map[string]float64 {
+	headroom := make(map[string]float64)
+	if elapsed <= 0 {
+		return headroom
+	}
+	prevCalls := make(map[string]map[string]uint64, len(prev.Disks))
+	for _, disk := range prev.Disks {
+		if disk.Metrics != nil {
+			prevCalls[disk.Endpoint] = disk.Metrics.APICalls
+		}
+	}
+	for _, disk := range cur.Disks {
+		before, ok := prevCalls[disk.Endpoint]
+		if !ok || disk.Metrics == nil || disk.Utilization <= 0 {
+			continue
+		}
+		var calls uint64
+		for api, n := range disk.Metrics.APICalls {
+			if n >= before[api] {
+				calls += n - before[api]
+			}
+		}
+		iops := float64(calls) / elapsed.Seconds()
+		util := min(disk.Utilization/100, 1)
+		headroom[disk.Endpoint] = (1 - util) * iops / util
+	}
+	return headroom
+}
+
+// WritePrometheus writes the space and state of each disk to w as gauges
+// in the Prometheus text exposition format, labeled with the endpoint,
+// pool and set of the disk. constLabels are added to every sample, for
+// example to identify the cluster.
+func (s StorageInfo) WritePrometheus(w io.Writer, constLabels map[string]string) error {
+	for name := range constLabels {
+		if !validPrometheusLabel(name) {
+			return fmt.Errorf("invalid Prometheus label name %q", name)
+		}
+	}
+	var common []string
+	for _, name := range sortedKeys(constLabels) {
+		if name == "endpoint" || name == "pool" || name == "set" {
+			continue
+		}
+		common = append(common, name+`="`+escapePrometheusLabel(constLabels[name])+`"`)
+	}
+
+	metrics := []struct {
+		name, help string
+		value      func(Disk) string
+	}{
+		{"minio_disk_total_bytes", "Total space of the disk in bytes.", func(d Disk) string {
+			return strconv.FormatUint(d.TotalSpace, 10)
+		}},
+		{"minio_disk_used_bytes", "Used space of the disk in bytes.", func(d Disk) string {
+			return strconv.FormatUint(d.UsedSpace, 10)
+		}},
+		{"minio_disk_online", "Whether the disk is online (1) or not (0).", func(d Disk) string {
+			if d.State == DriveStateOk {
+				return "1"
+			}
+			return "0"
+		}},
+	}
+
+	var b strings.Builder
+	for _, m := range metrics {
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", m.name, m.help, m.name)
+		for _, disk := range s.Disks {
+			labels := append(slices.Clone(common),
+				`endpoint="`+escapePrometheusLabel(disk.Endpoint)+`"`,
+				`pool="`+strconv.Itoa(disk.PoolIndex)+`"`,
+				`set="`+strconv.Itoa(disk.SetIndex)+`"`,
+			)
+			fmt.Fprintf(&b, "%s{%s} %s\n", m.name, strings.Join(labels, ","), m.value(disk))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// validPrometheusLabel returns true if name is a valid Prometheus label name.
+func validPrometheusLabel(name string) bool {
+	if name == "" || strings.HasPrefix(name, "__") {
+		return false
+	}
+	for i, c := range name {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// escapePrometheusLabel escapes a label value for the Prometheus text format.
+func escapePrometheusLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// DaysUntilFirstDriveFull projects the growth of each disk over samples,
+// which must be in chronological order and taken one day apart, and
+// returns the UUID of the disk expected to fill up first along with the
+// number of days until it does, counted from the last sample. Growth is
+// estimated with a least squares fit of the used space of each disk.
+// ok is false if no disk appears in at least two samples with growing
+// usage.
+func DaysUntilFirstDriveFull(samples []StorageInfo) (uuid string, days float64, ok bool) {
+	type point struct{ day, used float64 }
+	series := make(map[string][]point)
+	last := make(map[string]Disk)
+	for day, sample := range samples {
+		for _, disk := range sample.Disks {
+			if disk.UUID == "" || disk.RootDisk {
+				continue
+			}
+			series[disk.UUID] = append(series[disk.UUID], point{float64(day), float64(disk.UsedSpace)})
+			last[disk.UUID] = disk
+		}
+	}
+
+	for id, points := range series {
+		if len(points) < 2 {
+			continue
+		}
+		var sumX, sumY, sumXY, sumXX float64
+		for _, p := range points {
+			sumX += p.day
+			sumY += p.used
+			sumXY += p.day * p.used
+			sumXX += p.day * p.day
+		}
+		n := float64(len(points))
+		slope := (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+		if slope <= 0 {
+			continue
+		}
+		disk := last[id]
+		remaining := float64(disk.TotalSpace) - float64(disk.UsedSpace)
+		d := max(remaining, 0) / slope
+		if !ok || d < days || (d == days && id < uuid) {
+			uuid, days, ok = id, d, true
+		}
+	}
+	return uuid, days, ok
+}
+
+// NewlyHealingDisks returns the disks of cur that are healing but were
+// not healing in prev, matched by UUID. Disks not present in prev are
+// included if healing. Disks without a UUID cannot be matched and are
+// skipped.
+func NewlyHealingDisks(prev, cur StorageInfo) []Disk {
+	wasHealing := make(map[string]bool, len(prev.Disks))
+	for _, disk := range prev.Disks {
+		if disk.UUID != "" {
+			wasHealing[disk.UUID] = disk.Healing
+		}
+	}
+	return cur.FilterDisks(func(disk Disk) bool {
+		return disk.UUID != "" && disk.Healing && !wasHealing[disk.UUID]
+	})
+}
+
+// StorageInfoOpts holds the options for StorageInfo.
+//
+//msgp:ignore StorageInfoOpts
+type StorageInfoOpts struct {
+	// OfflineOnly trims StorageInfo.Disks to the disks that are not ok.
+	OfflineOnly bool
+}
+
+// WithOfflineOnly limits StorageInfo.Disks to the disks whose state is
+// not ok. The backend summary still reflects the whole cluster.
+func WithOfflineOnly(offlineOnly bool) func(*StorageInfoOpts) {
+	return func(opts *StorageInfoOpts) {
+		opts.OfflineOnly = offlineOnly
+	}
+}
+
+// filterDisks trims info.Disks as requested in opts.
+func (opts StorageInfoOpts) filterDisks(info *StorageInfo) {
+	if !opts.OfflineOnly {
+		return
+	}
+	info.Disks = slices.DeleteFunc(info.Disks, func(disk Disk) bool {
+		return disk.State == DriveStateOk
+	})
+}
+
+// StorageInfo - Connect to a minio server and call Storage Info Management API
+// to fetch server's information represented by StorageInfo structure
+func (adm *AdminClient) StorageInfo(ctx context.Context, options ...func(*StorageInfoOpts)) (StorageInfo, error) {
+	opts := &StorageInfoOpts{}
+	for _, o := range options {
+		o(opts)
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:       adminAPIPrefix + "/storageinfo",
+		customHeaders: acceptGzipHeaders(),
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return StorageInfo{}, err
+	}
+
+	// Check response http status code
+	if resp.StatusCode != http.StatusOK {
+		return StorageInfo{}, httpRespToErrorResponse(resp)
+	}
+
+	body, err := responseReader(resp)
+	if err != nil {
+		return StorageInfo{}, err
+	}
+
+	// Unmarshal the server's json response
+	var storageInfo StorageInfo
+	if err = json.NewDecoder(body).Decode(&storageInfo); err != nil {
+		return StorageInfo{}, err
+	}
+	opts.filterDisks(&storageInfo)
+
+	return storageInfo, nil
+}
+
+// StorageInfoStream iterates over the disks of a StorageInfo response
+// without decoding all of them in memory at once.
+//
+//msgp:ignore StorageInfoStream
+type StorageInfoStream struct {
+	resp    *http.Response
+	dec     *json.Decoder
+	backend BackendInfo
+	inDisks bool
+	err     error
+}
+
+// StorageInfoStream is like StorageInfo, but decodes the disks one at a
+// time as they are consumed with Next. This avoids large allocations on
+// deployments with many disks. The stream must be consumed until Next
+// returns an error, or closed with Close.
+func (adm *AdminClient) StorageInfoStream(ctx context.Context) (*StorageInfoStream, error) {
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:       adminAPIPrefix + "/storageinfo",
+		customHeaders: acceptGzipHeaders(),
+	})
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+
+	// Check response http status code
+	if resp.StatusCode != http.StatusOK {
+		defer closeResponse(resp)
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	body, err := responseReader(resp)
+	if err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+	s := &StorageInfoStream{resp: resp, dec: json.NewDecoder(body)}
+	if err = s.expectDelim('{'); err != nil {
+		closeResponse(resp)
+		return nil, err
+	}
+	return s, nil
+}
+
+// Next returns the next disk of the response. io.EOF is returned once
+// all disks were returned, after which Backend is available.
+func (s *StorageInfoStream) Next() (Disk, error) {
+	if s.err != nil {
+		return Disk{}, s.err
+	}
+	disk, err := s.next()
+	if err != nil {
+		s.err = err
+		closeResponse(s.resp)
+	}
+	return disk, err
+}
+
+func (s *StorageInfoStream) next() (Disk, error) {
+	for {
+		if s.inDisks {
+			if s.dec.More() {
+				var disk Disk
+				err := s.dec.Decode(&disk)
+				return disk, err
+			}
+			if err := s.expectDelim(']'); err != nil {
+				return Disk{}, err
+			}
+			s.inDisks = false
+		}
+
+		tok, err := s.dec.Token()
+		if err != nil {
+			return Disk{}, err
+		}
+		if tok == json.Delim('}') {
+			return Disk{}, io.EOF
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return Disk{}, fmt.Errorf("unexpected JSON token %v in StorageInfo", tok)
+		}
+		switch {
+		case strings.EqualFold(key, "Disks"):
+			tok, err = s.dec.Token()
+			if err != nil {
+				return Disk{}, err
+			}
+			switch tok {
+			case json.Delim('['):
+				s.inDisks = true
+			case nil:
+				// No disks.
+			default:
+				return Disk{}, fmt.Errorf("unexpected JSON token %v for StorageInfo disks", tok)
+			}
+		case strings.EqualFold(key, "Backend"):
+			if err = s.dec.Decode(&s.backend); err != nil {
+				return Disk{}, err
+			}
+		default:
+			var skip json.RawMessage
+			if err = s.dec.Decode(&skip); err != nil {
+				return Disk{}, err
+			}
+		}
+	}
+}
+
+func (s *StorageInfoStream) expectDelim(delim json.Delim) error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("unexpected JSON token %v in StorageInfo, want %v", tok, delim)
+	}
+	return nil
+}
+
+// Backend returns the backend information of the response. It is only
+// complete once Next returned io.EOF.
+func (s *StorageInfoStream) Backend() BackendInfo {
+	return s.backend
+}
+
+// Close releases the response, it is safe to call multiple times.
+func (s *StorageInfoStream) Close() error {
+	if s.err == nil {
+		s.err = errors.New("storage info stream closed")
+	}
+	closeResponse(s.resp)
+	return nil
+}
+
+// BucketUsageInfo - bucket usage info provides
+// - total size of the bucket
+// - total objects in a bucket
+// - object size histogram per bucket
+type BucketUsageInfo struct {
+	Size                    uint64 `json:"size"`
+	ReplicationPendingSize  uint64 `json:"objectsPendingReplicationTotalSize"`
+	ReplicationFailedSize   uint64 `json:"objectsFailedReplicationTotalSize"`
+	ReplicatedSize          uint64 `json:"objectsReplicatedTotalSize"`
+	ReplicaSize             uint64 `json:"objectReplicaTotalSize"`
+	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
+	ReplicationFailedCount  uint64 `json:"objectsFailedReplicationCount"`
+
+	VersionsCount           uint64            `json:"versionsCount"`
+	ObjectsCount            uint64            `json:"objectsCount"`
+	DeleteMarkersCount      uint64            `json:"deleteMarkersCount"`
+	ObjectSizesHistogram    map[string]uint64 `json:"objectsSizesHistogram"`
+	ObjectVersionsHistogram map[string]uint64 `json:"objectsVersionsHistogram"`
+
+	// Objects count per storage class, only set if reported by the server.
+	ObjectsCountByStorageClass map[string]uint64 `json:"objectsCountByStorageClass,omitempty"`
+}
+
+// ReplicationProgress returns the fraction, between 0 and 1, of the
+// bucket's replication workload that has completed. Buckets without
+// replication activity are reported as fully replicated (1.0).
+func (b BucketUsageInfo) ReplicationProgress() float64 {
+	total := b.ReplicatedSize + b.ReplicationPendingSize + b.ReplicationFailedSize
+	if total == 0 {
+		return 1
+	}
+	return float64(b.ReplicatedSize) / float64(total)
+}
+
+// objectSizeHistogramRanges lists the object size histogram keys reported
+// by the server, with the inclusive size range of each.
+var objectSizeHistogramRanges = []SizeBucket{
+	{Name: "LESS_THAN_1024_B", LowerBytes: 0, UpperBytes: humanize.KiByte - 1},
+	{Name: "BETWEEN_1024B_AND_64_KB", LowerBytes: humanize.KiByte, UpperBytes: humanize.KiByte*64 - 1},
+	{Name: "BETWEEN_64KB_AND_256_KB", LowerBytes: humanize.KiByte * 64, UpperBytes: humanize.KiByte*256 - 1},
+	{Name: "BETWEEN_256KB_AND_512_KB", LowerBytes: humanize.KiByte * 256, UpperBytes: humanize.KiByte*512 - 1},
+	{Name: "BETWEEN_512KB_AND_1_MB", LowerBytes: humanize.KiByte * 512, UpperBytes: humanize.MiByte - 1},
+	{Name: "BETWEEN_1024B_AND_1_MB", LowerBytes: humanize.KiByte, UpperBytes: humanize.MiByte - 1},
+	{Name: "BETWEEN_1_MB_AND_10_MB", LowerBytes: humanize.MiByte, UpperBytes: humanize.MiByte*10 - 1},
+	{Name: "BETWEEN_10_MB_AND_64_MB", LowerBytes: humanize.MiByte * 10, UpperBytes: humanize.MiByte*64 - 1},
+	{Name: "BETWEEN_64_MB_AND_128_MB", LowerBytes: humanize.MiByte * 64, UpperBytes: humanize.MiByte*128 - 1},
+	{Name: "BETWEEN_128_MB_AND_512_MB", LowerBytes: humanize.MiByte * 128, UpperBytes: humanize.MiByte*512 - 1},
+	{Name: "GREATER_THAN_512_MB", LowerBytes: humanize.MiByte * 512, UpperBytes: math.MaxInt64},
+}
+
+// SizeBucket holds the number of objects within an inclusive size range.
+//
+//msgp:ignore SizeBucket
+type SizeBucket struct {
+	Name       string // Histogram key reported by the server.
+	LowerBytes uint64
+	UpperBytes uint64
+	Count      uint64
+}
+
+// ParsedSizeHistogram returns ObjectSizesHistogram as size ranges, sorted
+// by lower bound. Note that BETWEEN_1024B_AND_1_MB overlaps the ranges
+// between 1 KiB and 1 MiB. An error naming the key is returned for keys
+// that are not known.
+func (b BucketUsageInfo) ParsedSizeHistogram() ([]SizeBucket, error) {
+	for _, key := range sortedKeys(b.ObjectSizesHistogram) {
+		if !slices.ContainsFunc(objectSizeHistogramRanges, func(r SizeBucket) bool { return r.Name == key }) {
+			return nil, fmt.Errorf("unknown object size histogram key %q", key)
+		}
+	}
+	buckets := make([]SizeBucket, 0, len(b.ObjectSizesHistogram))
+	for _, r := range objectSizeHistogramRanges {
+		if count, ok := b.ObjectSizesHistogram[r.Name]; ok {
+			r.Count = count
+			buckets = append(buckets, r)
+		}
+	}
+	sort.SliceStable(buckets, func(i, j int) bool {
+		return buckets[i].LowerBytes < buckets[j].LowerBytes
+	})
+	return buckets, nil
+}
+
+// DataUsageInfo represents data usage stats of the underlying Object API
+type DataUsageInfo struct {
+	// LastUpdate is the timestamp of when the data usage info was last updated.
+	// This does not indicate a full scan.
+	LastUpdate time.Time `json:"lastUpdate"`
+
+	// Objects total count across all buckets
+	ObjectsTotalCount uint64 `json:"objectsCount"`
+
+	// Objects total size across all buckets
+	ObjectsTotalSize uint64 `json:"objectsTotalSize"`
+
+	// Total Size for objects that have not yet been replicated
+	ReplicationPendingSize uint64 `json:"objectsPendingReplicationTotalSize"`
+
+	// Total size for objects that have witness one or more failures and will be retried
+	ReplicationFailedSize uint64 `json:"objectsFailedReplicationTotalSize"`
+
+	// Total size for objects that have been replicated to destination
+	ReplicatedSize uint64 `json:"objectsReplicatedTotalSize"`
+
+	// Total size for objects that are replicas
+	ReplicaSize uint64 `json:"objectsReplicaTotalSize"`
+
+	// Total number of objects pending replication
+	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
+
+	// Total number of objects that failed replication
+	ReplicationFailedCount uint64 `json:"objectsFailedReplicationCount"`
+
+	// Total number of buckets in this cluster
+	BucketsCount uint64 `json:"bucketsCount"`
+
+	// Buckets usage info provides following information across all buckets
+	// - total size of the bucket
+	// - total objects in a bucket
+	// - object size histogram per bucket
+	BucketsUsage map[string]BucketUsageInfo `json:"bucketsUsageInfo"`
+
+	// TierStats holds per-tier stats like bytes tiered, etc.
+	TierStats map[string]TierStats `json:"tierStats"`
+
+	// Server capacity related data
+	TotalCapacity     uint64 `json:"capacity"`
+	TotalFreeCapacity uint64 `json:"freeCapacity"`
+	TotalUsedCapacity uint64 `json:"usedCapacity"`
+}
+
+// FillPercent returns the used capacity of the cluster as a percentage of
+// its total capacity, or 0 if the capacity is unknown.
+func (d DataUsageInfo) FillPercent() float64 {
+	if d.TotalCapacity == 0 {
+		return 0
+	}
+	return float64(d.TotalUsedCapacity) * 100 / float64(d.TotalCapacity)
+}
+
+// ReplicationRole describes the part a deployment plays in replication.
+type ReplicationRole string
+
+const (
+	// ReplicationNone indicates no data is replicated to or from the deployment
+	ReplicationNone = ReplicationRole("none")
+	// ReplicationSource indicates data is replicated from the deployment
+	ReplicationSource = ReplicationRole("source")
+	// ReplicationTarget indicates data is replicated to the deployment
+	ReplicationTarget = ReplicationRole("target")
+	// ReplicationBoth indicates data is replicated both to and from the deployment
+	ReplicationBoth = ReplicationRole("both")
+)
+
+// ReplicationRole returns whether the deployment replicates data to
+// other deployments, holds replicas from other deployments, or both.
+func (d DataUsageInfo) ReplicationRole() ReplicationRole {
+	switch outbound, inbound := d.ReplicatedSize > 0, d.ReplicaSize > 0; {
+	case outbound && inbound:
+		return ReplicationBoth
+	case outbound:
+		return ReplicationSource
+	case inbound:
+		return ReplicationTarget
+	default:
+		return ReplicationNone
+	}
+}
+
+// LaggingBuckets returns the sorted names of the buckets whose
+// replication progress is below threshold.
+func (d DataUsageInfo) LaggingBuckets(threshold float64) []string {
+	var buckets []string
+	for bucket, usage := range d.BucketsUsage {
+		if usage.ReplicationProgress() < threshold {
+			buckets = append(buckets, bucket)
+		}
+	}
+	sort.Strings(buckets)
+	return buckets
+}
+
+// EmptyBuckets returns the sorted names of the buckets holding no data,
+// objects or versions. Buckets holding only delete markers are excluded,
+// as they cannot be removed without first removing the delete markers.
+func (d DataUsageInfo) EmptyBuckets() []string {
+	var buckets []string
+	for bucket, usage := range d.BucketsUsage {
+		if usage.Size == 0 && usage.ObjectsCount == 0 && usage.VersionsCount == 0 && usage.DeleteMarkersCount == 0 {
+			buckets = append(buckets, bucket)
+		}
+	}
+	sort.Strings(buckets)
+	return buckets
+}
+
+// BucketObjectSkew returns the Gini coefficient of the objects count of
+// the buckets: 0 when objects are evenly spread across buckets, tending
+// towards 1 as they concentrate in a single bucket. Returns 0 when there
+// are fewer than two buckets or no objects.
+func (d DataUsageInfo) BucketObjectSkew() (giniCoefficient float64) {
+	if len(d.BucketsUsage) < 2 {
+		return 0
+	}
+	counts := make([]uint64, 0, len(d.BucketsUsage))
+	var total float64
+	for _, usage := range d.BucketsUsage {
+		counts = append(counts, usage.ObjectsCount)
+		total += float64(usage.ObjectsCount)
+	}
+	if total == 0 {
+		return 0
+	}
+	slices.Sort(counts)
+	var weighted float64
+	for i, c := range counts {
+		weighted += float64(i+1) * float64(c)
+	}
+	n := float64(len(counts))
+	return 2*weighted/(n*total) - (n+1)/n
+}
+
+// ObjectsByStorageClass returns the objects count per storage class
+// across all buckets. The returned map is empty if the server does
+// not report a storage class breakdown.
+func (d DataUsageInfo) ObjectsByStorageClass() map[string]uint64 {
+	counts := make(map[string]uint64)
+	for _, usage := range d.BucketsUsage {
+		for sc, count := range usage.ObjectsCountByStorageClass {
+			counts[sc] += count
+		}
+	}
+	return counts
+}
+
+// FilterBuckets returns a copy of d limited to the buckets whose name
+// starts with prefix, with the bucket, object and replication totals
+// recomputed from them. The capacity and tier stats are left unchanged.
+// An empty prefix returns a copy of d as is. The maps of the copy are
+// cloned, so modifying them does not affect d.
+func (d DataUsageInfo) FilterBuckets(prefix string) DataUsageInfo {
+	filtered := d
+	filtered.TierStats = maps.Clone(d.TierStats)
+	if prefix == "" {
+		filtered.BucketsUsage = maps.Clone(d.BucketsUsage)
+		return filtered
+	}
+	filtered.BucketsUsage = make(map[string]BucketUsageInfo)
+	filtered.BucketsCount = 0
+	filtered.ObjectsTotalCount = 0
+	filtered.ObjectsTotalSize = 0
+	filtered.ReplicationPendingSize = 0
+	filtered.ReplicationFailedSize = 0
+	filtered.ReplicatedSize = 0
+	filtered.ReplicaSize = 0
+	filtered.ReplicationPendingCount = 0
+	filtered.ReplicationFailedCount = 0
+	for bucket, usage := range d.BucketsUsage {
+		if !strings.HasPrefix(bucket, prefix) {
+			continue
+		}
+		filtered.BucketsUsage[bucket] = usage
+		filtered.BucketsCount++
+		filtered.ObjectsTotalCount += usage.ObjectsCount
+		filtered.ObjectsTotalSize += usage.Size
+		filtered.ReplicationPendingSize += usage.ReplicationPendingSize
+		filtered.ReplicationFailedSize += usage.ReplicationFailedSize
+		filtered.ReplicatedSize += usage.ReplicatedSize
+		filtered.ReplicaSize += usage.ReplicaSize
+		filtered.ReplicationPendingCount += usage.ReplicationPendingCount
+		filtered.ReplicationFailedCount += usage.ReplicationFailedCount
+	}
+	return filtered
+}
+
+// NamedBucketUsage is the usage of a bucket along with its name.
+//
+//msgp:ignore NamedBucketUsage
+type NamedBucketUsage struct {
+	Name string
+	BucketUsageInfo
+}
+
+// TopBucketsBySize returns the n largest buckets by size, in descending
+// order. Buckets of equal size are ordered by name. All buckets are
+// returned when n exceeds the number of buckets.
+func (d DataUsageInfo) TopBucketsBySize(n int) []NamedBucketUsage {
+	if n <= 0 {
+		return nil
+	}
+	buckets := make([]NamedBucketUsage, 0, len(d.BucketsUsage))
+	for name, usage := range d.BucketsUsage {
+		buckets = append(buckets, NamedBucketUsage{Name: name, BucketUsageInfo: usage})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Size != buckets[j].Size {
+			return buckets[i].Size > buckets[j].Size
+		}
+		return buckets[i].Name < buckets[j].Name
+	})
+	if n < len(buckets) {
+		buckets = buckets[:n]
+	}
+	return buckets
+}
+
+// Age returns the time elapsed from LastUpdate to now, or 0 if
+// LastUpdate is not set.
+func (d DataUsageInfo) Age(now time.Time) time.Duration {
+	if d.LastUpdate.IsZero() {
+		return 0
+	}
+	return now.Sub(d.LastUpdate)
+}
+
+// IsStale returns true if LastUpdate is older than maxAge at now, or is
+// not set.
+func (d DataUsageInfo) IsStale(maxAge time.Duration, now time.Time) bool {
+	return d.LastUpdate.IsZero() || d.Age(now) > maxAge
+}
+
+// NormalizeTimes converts all time fields of d to UTC, so that
+// snapshots compare equal regardless of the server locale.
+func (d *DataUsageInfo) NormalizeTimes() {
+	d.LastUpdate = d.LastUpdate.UTC()
+}
+
+// ReplicationBacklog summarizes the pending and failed replication
+// workload across all buckets.
+//
+//msgp:ignore ReplicationBacklog
+type ReplicationBacklog struct {
+	PendingSize  uint64
+	FailedSize   uint64
+	PendingCount uint64
+	FailedCount  uint64
+
+	// WorstBucket is the bucket with the largest failed replication
+	// size, empty if no bucket has failed replication.
+	WorstBucket           string
+	WorstBucketFailedSize uint64
+}
+
+// ReplicationBacklog returns the replication backlog summed across all
+// buckets. Buckets with equal failed replication size are ordered by
+// name when picking the worst bucket.
+func (d DataUsageInfo) ReplicationBacklog() (backlog ReplicationBacklog) {
+	for bucket, usage := range d.BucketsUsage {
+		backlog.PendingSize += usage.ReplicationPendingSize
+		backlog.FailedSize += usage.ReplicationFailedSize
+		backlog.PendingCount += usage.ReplicationPendingCount
+		backlog.FailedCount += usage.ReplicationFailedCount
+		if usage.ReplicationFailedSize == 0 {
+			continue
+		}
+		if usage.ReplicationFailedSize > backlog.WorstBucketFailedSize ||
+			(usage.ReplicationFailedSize == backlog.WorstBucketFailedSize && bucket < backlog.WorstBucket) {
+			backlog.WorstBucket = bucket
+			backlog.WorstBucketFailedSize = usage.ReplicationFailedSize
+		}
+	}
+	return backlog
+}
+
+// BucketUsageDelta holds the signed change in usage of a bucket between
+// two DataUsageInfo snapshots.
+//
+//msgp:ignore BucketUsageDelta
+type BucketUsageDelta struct {
+	Size               int64
+	ObjectsCount       int64
+	VersionsCount      int64
+	DeleteMarkersCount int64
+
+	// Elapsed is the time between the two snapshots.
+	Elapsed time.Duration
+}
+
+// DiffBuckets returns the per-bucket usage change from prev to d.
+// Buckets present in only one of the snapshots are compared against
+// zero usage, so removed buckets have negative deltas.
+func (d DataUsageInfo) DiffBuckets(prev DataUsageInfo) map[string]BucketUsageDelta {
+	elapsed := d.LastUpdate.Sub(prev.LastUpdate)
+	diff := func(cur, old uint64) int64 {
+		return int64(cur) - int64(old)
+	}
+	deltas := make(map[string]BucketUsageDelta, len(d.BucketsUsage))
+	for bucket, cur := range d.BucketsUsage {
+		old := prev.BucketsUsage[bucket]
+		deltas[bucket] = BucketUsageDelta{
+			Size:               diff(cur.Size, old.Size),
+			ObjectsCount:       diff(cur.ObjectsCount, old.ObjectsCount),
+			VersionsCount:      diff(cur.VersionsCount, old.VersionsCount),
+			DeleteMarkersCount: diff(cur.DeleteMarkersCount, old.DeleteMarkersCount),
+			Elapsed:            elapsed,
+		}
+	}
+	for bucket, old := range prev.BucketsUsage {
+		if _, ok := d.BucketsUsage[bucket]; ok {
+			continue
+		}
+		deltas[bucket] = BucketUsageDelta{
+			Size:               -int64(old.Size),
+			ObjectsCount:       -int64(old.ObjectsCount),
+			VersionsCount:      -int64(old.VersionsCount),
+			DeleteMarkersCount: -int64(old.DeleteMarkersCount),
+			Elapsed:            elapsed,
+		}
+	}
+	return deltas
+}
+
+// DataUsageOpts holds the options for DataUsageInfo.
+//
+//msgp:ignore DataUsageOpts
+type DataUsageOpts struct {
+	// Timeout bounds the call, if non-zero.
+	Timeout time.Duration
+
+	// Capacity asks the server to compute the capacity of the cluster,
+	// true by default. When false, TotalCapacity, TotalFreeCapacity and
+	// TotalUsedCapacity may be zero.
+	Capacity bool
+}
+
+// WithUsageTimeout bounds the DataUsageInfo call to d, without affecting
+// other calls made with the same client.
+func WithUsageTimeout(d time.Duration) func(*DataUsageOpts) {
+	return func(opts *DataUsageOpts) {
+		opts.Timeout = d
+	}
+}
+
+// WithCapacity sets whether the server should compute the capacity of
+// the cluster, which can be expensive on large clusters.
+func WithCapacity(capacity bool) func(*DataUsageOpts) {
+	return func(opts *DataUsageOpts) {
+		opts.Capacity = capacity
+	}
+}
+
+// DataUsageInfo - returns data usage of the current object API
+func (adm *AdminClient) DataUsageInfo(ctx context.Context, options ...func(*DataUsageOpts)) (DataUsageInfo, error) {
+	opts := &DataUsageOpts{Capacity: true}
+	for _, o := range options {
+		o(opts)
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	values := make(url.Values)
+	values.Set("capacity", strconv.FormatBool(opts.Capacity))
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, requestData{
+		relPath:       adminAPIPrefix + "/datausageinfo",
+		queryValues:   values,
+		customHeaders: acceptGzipHeaders(),
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return DataUsageInfo{}, fmt.Errorf("data usage info: %w", ctx.Err())
+		}
+		return DataUsageInfo{}, err
+	}
+
+	// Check response http status code
+	if resp.StatusCode != http.StatusOK {
+		return DataUsageInfo{}, httpRespToErrorResponse(resp)
+	}
+
+	body, err := responseReader(resp)
+	if err != nil {
+		return DataUsageInfo{}, err
+	}
+
+	// Unmarshal the server's json response
+	var dataUsageInfo DataUsageInfo
+	if err = json.NewDecoder(body).Decode(&dataUsageInfo); err != nil {
+		return DataUsageInfo{}, err
+	}
+
+	return dataUsageInfo, nil
+}
+
+// capacityTolerance is the relative difference tolerated by
+// ReconcileCapacity between the two reported capacities.
+const capacityTolerance = 0.01
+
+// ReconcileCapacity compares the usable capacity summed across the disks
+// of si with the capacity reported by du. As the server only counts data
+// drives in du, the space of each disk of an erasure backend is scaled by
+// the data/(data+parity) ratio of its pool. withinTolerance is true when
+// they differ by no more than 1% of the larger value. Root disks, and
+// disks of pools with an unknown erasure layout, are skipped.
+func ReconcileCapacity(si StorageInfo, du DataUsageInfo) (siTotal, duTotal uint64, withinTolerance bool) {
+	var total float64
+	for _, disk := range si.Disks {
+		if disk.RootDisk {
+			continue
+		}
+		if ratio, ok := si.dataRatio(disk.PoolIndex); ok {
+			total += float64(disk.TotalSpace) * ratio
+		}
+	}
+	siTotal = uint64(total)
+	duTotal = du.TotalCapacity
+
+	diff := float64(max(siTotal, duTotal) - min(siTotal, duTotal))
+	return siTotal, duTotal, diff <= capacityTolerance*float64(max(siTotal, duTotal))
+}
+
+// ErasureSetInfo provides information per erasure set
+type ErasureSetInfo struct {
+	ID                 int      `json:"id"`
+	RawUsage           uint64   `json:"rawUsage"`
+	RawCapacity        uint64   `json:"rawCapacity"`
+	Usage              uint64   `json:"usage"`
+	ObjectsCount       uint64   `json:"objectsCount"`
+	VersionsCount      uint64   `json:"versionsCount"`
+	DeleteMarkersCount uint64   `json:"deleteMarkersCount"`
+	HealDisks          int      `json:"healDisks"`
+	OnlineDisks        int      `json:"onlineDisks,omitempty"`
+	OfflineDisks       int      `json:"offlineDisks,omitempty"`
+	Nodes              []string `json:"nodes,omitempty"`
+}
+
+// HealthRatio returns the online disks of the set as a percentage of all
+// its disks, or 0 if the set reports no disks.
+func (e ErasureSetInfo) HealthRatio() float64 {
+	total := e.OnlineDisks + e.OfflineDisks
+	if total <= 0 {
+		return 0
+	}
+	return float64(e.OnlineDisks) * 100 / float64(total)
+}
+
+// NeedsHeal returns true if the set has disks healing or offline.
+func (e ErasureSetInfo) NeedsHeal() bool {
+	return e.HealDisks > 0 || e.OfflineDisks > 0
+}
+
+// InfoMessage container to hold server admin related information.
+type InfoMessage struct {
+	Mode             string             `json:"mode,omitempty"`
+	Domain           []string           `json:"domain,omitempty"`
+	Region           string             `json:"region,omitempty"`
+	SQSARN           []string           `json:"sqsARN,omitempty"`
+	DeploymentID     string             `json:"deploymentID,omitempty"`
+	ObjectNamingMode string             `json:"objectNamingMode,omitempty"`
+	Buckets          Buckets            `json:"buckets,omitzero"`
+	Objects          Objects            `json:"objects,omitzero"`
+	Versions         Versions           `json:"versions,omitzero"`
+	DeleteMarkers    DeleteMarkers      `json:"deletemarkers,omitzero"`
+	Usage            Usage              `json:"usage,omitzero"`
+	Services         Services           `json:"services,omitempty"`
+	Backend          ErasureBackend     `json:"backend,omitempty"`
+	Servers          []ServerProperties `json:"servers,omitempty"`
+
+	Pools map[int]map[int]ErasureSetInfo `json:"pools,omitempty"`
+
+	// Populated by ServerInfo, not part of the server response.
+	fetchedAt time.Time
+	source    string
+}
+
+// FetchedAt returns the time at which ServerInfo retrieved this message.
+func (info InfoMessage) FetchedAt() time.Time {
+	return info.fetchedAt
+}
+
+// Source returns the admin endpoint ServerInfo retrieved this message from.
+func (info InfoMessage) Source() string {
+	return info.source
+}
+
+// Snapshot wraps the message along with its fetch metadata, which
+// is otherwise lost when the message is serialized.
+func (info InfoMessage) Snapshot() InfoSnapshot {
+	return InfoSnapshot{
+		Info:      info,
+		FetchedAt: info.fetchedAt,
+		Source:    info.source,
+	}
+}
+
+// InfoSnapshot is an InfoMessage archived along with when and
+// where it was fetched.
+type InfoSnapshot struct {
+	Info      InfoMessage `json:"info"`
+	FetchedAt time.Time   `json:"fetchedAt"`
+	Source    string      `json:"source"`
+}
+
+// Message returns the archived InfoMessage with its fetch metadata restored.
+func (s InfoSnapshot) Message() InfoMessage {
+	info := s.Info
+	info.fetchedAt = s.FetchedAt
+	info.source = s.Source
+	return info
+}
+
+func (info InfoMessage) BackendType() BackendType {
+	// MinIO server type default
+	switch info.Backend.Type {
+	case "Erasure":
+		return Erasure
+	case "FS":
+		return FS
+	default:
+		return Unknown
+	}
+}
+
+func (info InfoMessage) StandardParity() int {
+	switch info.BackendType() {
+	case Erasure:
+		return info.Backend.StandardSCParity
+	default:
+		return -1
+	}
+}
+
+// ErrNotErasureBackend is returned when an erasure-only value is requested
+// from a deployment that is not running an Erasure backend.
+var ErrNotErasureBackend = errors.New("backend is not erasure coded")
+
+// StandardParityErr returns the standard storage class parity, or
+// ErrNotErasureBackend when the backend is not Erasure.
+func (info InfoMessage) StandardParityErr() (int, error) {
+	if info.BackendType() != Erasure {
+		return 0, ErrNotErasureBackend
+	}
+	return info.Backend.StandardSCParity, nil
+}
+
+// IsDegraded returns true if any server is not online, any drive is
+// offline or any drive is healing, along with the reasons why.
+func (info InfoMessage) IsDegraded() (bool, []string) {
+	var reasons []string
+	for _, srv := range info.Servers {
+		if srv.State != string(ItemOnline) {
+			reasons = append(reasons, fmt.Sprintf("server %s is %s", srv.Endpoint, srv.State))
+		}
+		for _, disk := range srv.Disks {
+			if disk.Healing {
+				reasons = append(reasons, fmt.Sprintf("drive %s is healing", disk.Endpoint))
+			}
+		}
+	}
+	if info.Backend.OfflineDisks > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d drives are offline", info.Backend.OfflineDisks))
+	}
+	return len(reasons) > 0, reasons
+}
+
+// Storage classes supported by MinIO.
+const (
+	StorageClassStandard          = "STANDARD"
+	StorageClassReducedRedundancy = "REDUCED_REDUNDANCY"
+)
+
+// ConfiguredStorageClasses returns the storage classes configured on the
+// deployment. STANDARD is always available, REDUCED_REDUNDANCY only when
+// its parity is configured.
+func (info InfoMessage) ConfiguredStorageClasses() []string {
+	classes := []string{StorageClassStandard}
+	if info.Backend.RRSCParity > 0 {
+		classes = append(classes, StorageClassReducedRedundancy)
+	}
+	return classes
+}
+
+// CountsReported returns true if the buckets, objects, versions, delete
+// markers and usage counts were all part of the decoded response, in
+// which case zero counts are genuine.
+func (info InfoMessage) CountsReported() bool {
+	return info.Buckets.Reported && info.Objects.Reported && info.Versions.Reported &&
+		info.DeleteMarkers.Reported && info.Usage.Reported
+}
+
+// CollectErrors returns the errors reported by the server for the
+// buckets, objects, versions, delete markers and usage counts, keyed by
+// their JSON field name. An empty map means no count has an error.
+func (info InfoMessage) CollectErrors() map[string]string {
+	errs := make(map[string]string)
+	for name, err := range map[string]string{
+		"buckets":       info.Buckets.Error,
+		"objects":       info.Objects.Error,
+		"versions":      info.Versions.Error,
+		"deletemarkers": info.DeleteMarkers.Error,
+		"usage":         info.Usage.Error,
+	} {
+		if err != "" {
+			errs[name] = err
+		}
+	}
+	return errs
+}
+
+// Flatten returns the JSON encoding of info as flat key/value pairs.
+// Keys are the dot separated path of each scalar value, made of the JSON
+// field names, map keys and slice indexes, like "servers.0.drives.2.state".
+// Values are stringified, null values are skipped. Returns nil if info
+// cannot be JSON encoded.
+func (info InfoMessage) Flatten() map[string]string {
+	buf, err := json.Marshal(info)
+	if err != nil {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v any
+	if err = dec.Decode(&v); err != nil {
+		return nil
+	}
+	flat := make(map[string]string)
+	flattenJSON(flat, "", v)
+	return flat
+}
+
+// flattenJSON adds the scalar values of the decoded JSON value v to flat,
+// keyed by their path from prefix.
+func flattenJSON(flat map[string]string, prefix string, v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, elem := range v {
+			flattenJSON(flat, joinJSONPath(prefix, key), elem)
+		}
+	case []any:
+		for i, elem := range v {
+			flattenJSON(flat, joinJSONPath(prefix, strconv.Itoa(i)), elem)
+		}
+	case nil:
+	default:
+		flat[prefix] = fmt.Sprint(v)
+	}
+}
+
+// HasDriveMetrics returns true if any drive reports metrics, which
+// servers only send when asked with WithDriveMetrics.
+func (info InfoMessage) HasDriveMetrics() bool {
+	for _, srv := range info.Servers {
+		for _, disk := range srv.Disks {
+			if disk.Metrics != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Validate checks the internal consistency of the message, as received
+// from older servers or partial responses, and returns an error listing
+// every inconsistency found.
+func (info InfoMessage) Validate() error {
+	var errs []error
+	if info.BackendType() == Erasure {
+		b := info.Backend
+		if len(b.TotalSets) != len(b.DrivesPerSet) {
+			errs = append(errs, fmt.Errorf("backend reports %d pools with sets but %d pools with drives per set", len(b.TotalSets), len(b.DrivesPerSet)))
+		}
+		if len(info.Servers) > 0 {
+			var drives int
+			for _, srv := range info.Servers {
+				drives += len(srv.Disks)
+			}
+			if reported := b.OnlineDisks + b.OfflineDisks; reported != drives {
+				errs = append(errs, fmt.Errorf("backend reports %d drives but servers report %d", reported, drives))
+			}
+		}
+	}
+	for _, pool := range slices.Sorted(maps.Keys(info.Pools)) {
+		if pool < 0 {
+			errs = append(errs, fmt.Errorf("invalid pool index %d", pool))
+		}
+		for _, set := range slices.Sorted(maps.Keys(info.Pools[pool])) {
+			if set < 0 {
+				errs = append(errs, fmt.Errorf("invalid set index %d in pool %d", set, pool))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// FilterServers returns the servers for which pred returns true.
+func (info InfoMessage) FilterServers(pred func(ServerProperties) bool) []ServerProperties {
+	var servers []ServerProperties
+	for _, srv := range info.Servers {
+		if pred(srv) {
+			servers = append(servers, srv)
+		}
+	}
+	return servers
+}
+
+// ValidDeploymentID returns true if DeploymentID is a well-formed UUID.
+// An empty or malformed deployment ID indicates a server problem.
+func (info InfoMessage) ValidDeploymentID() bool {
+	_, err := uuid.Parse(info.DeploymentID)
+	return err == nil
+}
+
+// NormalizedDeploymentID returns DeploymentID in its canonical lowercase
+// UUID form, or an empty string if it is not a well-formed UUID.
+func (info InfoMessage) NormalizedDeploymentID() string {
+	id, err := uuid.Parse(info.DeploymentID)
+	if err != nil {
+		return ""
+	}
+	return id.String()
+}
+
+// MostErroringServer returns the endpoint of the server whose drives
+// report the most availability and timeout errors, along with that
+// error count. ok is false when no drive metrics are present.
+func (info InfoMessage) MostErroringServer() (endpoint string, errs uint64, ok bool) {
+	for _, srv := range info.Servers {
+		var total uint64
+		var found bool
+		for _, disk := range srv.Disks {
+			if disk.Metrics == nil {
+				continue
+			}
+			found = true
+			total += disk.Metrics.TotalErrorsAvailability + disk.Metrics.TotalErrorsTimeout
+		}
+		if !found {
+			continue
+		}
+		if !ok || total > errs {
+			endpoint, errs, ok = srv.Endpoint, total, true
+		}
+	}
+	return endpoint, errs, ok
+}
+
+// RawCapacityFromPools returns the raw capacity and usage summed across
+// all erasure sets in Pools, avoiding a separate StorageInfo call.
+func (info InfoMessage) RawCapacityFromPools() (capacity, usage uint64) {
+	for _, sets := range info.Pools {
+		for _, set := range sets {
+			capacity += set.RawCapacity
+			usage += set.RawUsage
+		}
+	}
+	return capacity, usage
+}
+
+// MinDrivesForAvailability returns the number of drives that must stay
+// online for every erasure set to retain read quorum, summed across all
+// sets in the cluster.
+func (info InfoMessage) MinDrivesForAvailability() (drives int) {
+	for pool, sets := range info.Backend.TotalSets {
+		if pool >= len(info.Backend.DrivesPerSet) {
+			break
+		}
+		readQuorum := info.Backend.DrivesPerSet[pool] - info.Backend.StandardSCParity
+		if readQuorum > 0 {
+			drives += sets * readQuorum
+		}
+	}
+	return drives
+}
+
+// SPOF describes a node whose loss would drop an erasure set below
+// read quorum.
+//
+//msgp:ignore SPOF
+type SPOF struct {
+	Node       string
+	Pool       int
+	Set        int
+	Remaining  int // Online drives left in the set without the node.
+	ReadQuorum int
+}
+
+// SinglePointsOfFailure returns every node whose loss would drop an
+// erasure set below read quorum, given the drives currently online.
+// The result is empty for a cluster that can tolerate losing any node.
+func (info InfoMessage) SinglePointsOfFailure() []SPOF {
+	type setKey struct{ pool, set int }
+	online := make(map[setKey]int)
+	perNode := make(map[string]map[setKey]int)
+	for _, srv := range info.Servers {
+		for _, disk := range srv.Disks {
+			if disk.State != DriveStateOk || disk.PoolIndex < 0 || disk.SetIndex < 0 {
+				continue
+			}
+			key := setKey{disk.PoolIndex, disk.SetIndex}
+			online[key]++
+			if perNode[srv.Endpoint] == nil {
+				perNode[srv.Endpoint] = make(map[setKey]int)
+			}
+			perNode[srv.Endpoint][key]++
+		}
+	}
+
+	var spofs []SPOF
+	for node, sets := range perNode {
+		for key, drives := range sets {
+			if key.pool >= len(info.Backend.DrivesPerSet) {
+				continue
+			}
+			readQuorum := info.Backend.DrivesPerSet[key.pool] - info.Backend.StandardSCParity
+			if remaining := online[key] - drives; remaining < readQuorum {
+				spofs = append(spofs, SPOF{
+					Node:       node,
+					Pool:       key.pool,
+					Set:        key.set,
+					Remaining:  remaining,
+					ReadQuorum: readQuorum,
+				})
+			}
+		}
+	}
+	sort.Slice(spofs, func(i, j int) bool {
+		if spofs[i].Node != spofs[j].Node {
+			return spofs[i].Node < spofs[j].Node
+		}
+		if spofs[i].Pool != spofs[j].Pool {
+			return spofs[i].Pool < spofs[j].Pool
+		}
+		return spofs[i].Set < spofs[j].Set
+	})
+	return spofs
+}
+
+// SetRef identifies an erasure set within a pool.
+//
+//msgp:ignore SetRef
+type SetRef struct {
+	Pool int
+	Set  int
+}
+
+// SetFailureBudget returns, per erasure set, the number of additional
+// drive failures the set can tolerate before losing write quorum.
+// Negative values indicate write quorum is already lost.
+func (info InfoMessage) SetFailureBudget() map[SetRef]int {
+	budget := make(map[SetRef]int)
+	for pool, sets := range info.Pools {
+		for idx, set := range sets {
+			drives := set.OnlineDisks + set.OfflineDisks
+			if pool < len(info.Backend.DrivesPerSet) {
+				drives = info.Backend.DrivesPerSet[pool]
+			}
+			budget[SetRef{Pool: pool, Set: idx}] = parityTolerance(info.Backend.StandardSCParity, drives) - set.OfflineDisks
+		}
+	}
+	return budget
+}
+
+// PoolFaultTolerance returns, per pool, the number of additional drive
+// failures the most fragile erasure set of the pool can tolerate before
+// losing write quorum. Negative values indicate write quorum is already lost.
+func (info InfoMessage) PoolFaultTolerance() map[int]int {
+	tolerance := make(map[int]int, len(info.Pools))
+	for ref, remaining := range info.SetFailureBudget() {
+		if cur, ok := tolerance[ref.Pool]; !ok || remaining < cur {
+			tolerance[ref.Pool] = remaining
+		}
+	}
+	return tolerance
+}
+
+// CanonicalEndpoints returns the sorted, deduplicated endpoints of all
+// servers and their disks, with the scheme and host lowercased, so that
+// they can be compared across calls.
+func (info InfoMessage) CanonicalEndpoints() []string {
+	seen := make(map[string]struct{})
+	var endpoints []string
+	add := func(endpoint string) {
+		if endpoint == "" {
+			return
+		}
+		endpoint = canonicalEndpoint(endpoint)
+		if _, ok := seen[endpoint]; ok {
+			return
+		}
+		seen[endpoint] = struct{}{}
+		endpoints = append(endpoints, endpoint)
+	}
+	for _, srv := range info.Servers {
+		add(srv.Endpoint)
+		for _, disk := range srv.Disks {
+			add(disk.Endpoint)
+		}
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+// canonicalEndpoint lowercases the scheme and host of an endpoint,
+// leaving its path untouched.
+func canonicalEndpoint(endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err == nil {
+			u.Scheme = strings.ToLower(u.Scheme)
+			u.Host = strings.ToLower(u.Host)
+			return u.String()
+		}
+	}
+	if strings.HasPrefix(endpoint, "/") {
+		// Local path.
+		return endpoint
+	}
+	host, path, _ := strings.Cut(endpoint, "/")
+	if path == "" && !strings.HasSuffix(endpoint, "/") {
+		return strings.ToLower(host)
+	}
+	return strings.ToLower(host) + "/" + path
+}
+
+// EndpointState holds a scheme qualified server endpoint and its state.
+//
+//msgp:ignore EndpointState
+type EndpointState struct {
+	Endpoint string
+	State    string
+	Online   bool
+}
+
+// DiscoverEndpointsWithState returns the sorted, deduplicated, scheme
+// qualified endpoints of all servers along with their reported state.
+// Servers without a scheme are assumed to use http.
+func (info InfoMessage) DiscoverEndpointsWithState() []EndpointState {
+	seen := make(map[string]struct{}, len(info.Servers))
+	endpoints := make([]EndpointState, 0, len(info.Servers))
+	for _, srv := range info.Servers {
+		if srv.Endpoint == "" {
+			continue
+		}
+		scheme := srv.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		endpoint := scheme + "://" + srv.Endpoint
+		if _, ok := seen[endpoint]; ok {
+			continue
+		}
+		seen[endpoint] = struct{}{}
+		endpoints = append(endpoints, EndpointState{
+			Endpoint: endpoint,
+			State:    srv.State,
+			Online:   ItemState(srv.State) == ItemOnline,
+		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		return endpoints[i].Endpoint < endpoints[j].Endpoint
+	})
+	return endpoints
+}
+
+// DiscoverEndpoints returns the sorted, deduplicated, scheme qualified
+// endpoints of all servers, including offline ones, suitable for seeding
+// a client side endpoint pool.
+func (info InfoMessage) DiscoverEndpoints() ([]string, error) {
+	states := info.DiscoverEndpointsWithState()
+	if len(states) == 0 {
+		return nil, errors.New("no server endpoints found")
+	}
+	endpoints := make([]string, 0, len(states))
+	for _, state := range states {
+		u, err := url.Parse(state.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if u.Host == "" {
+			return nil, fmt.Errorf("invalid server endpoint %q", state.Endpoint)
+		}
+		endpoints = append(endpoints, u.String())
+	}
+	return endpoints, nil
+}
+
+// LeaderCount returns the number of servers reporting themselves as leader.
+func (info InfoMessage) LeaderCount() (n int) {
+	for _, srv := range info.Servers {
+		if srv.IsLeader {
+			n++
+		}
+	}
+	return n
+}
+
+// LeaderState describes the outcome of leader election across servers.
+type LeaderState string
+
+const (
+	// LeaderSingle indicates exactly one server is leader
+	LeaderSingle = LeaderState("single")
+	// LeaderNone indicates no server is leader, election may be in progress
+	LeaderNone = LeaderState("none")
+	// LeaderMultiple indicates more than one server claims to be leader
+	LeaderMultiple = LeaderState("multiple")
+)
+
+// LeaderElectionState reports whether exactly one, none or multiple
+// servers claim leadership.
+func (info InfoMessage) LeaderElectionState() LeaderState {
+	switch info.LeaderCount() {
+	case 0:
+		return LeaderNone
+	case 1:
+		return LeaderSingle
+	default:
+		return LeaderMultiple
+	}
+}
+
+// InconsistentLeaders returns the endpoints of the servers flagged as
+// leader while not online, which indicates stale server state.
+func (info InfoMessage) InconsistentLeaders() []string {
+	var endpoints []string
+	for _, srv := range info.Servers {
+		if srv.IsLeader && srv.State != string(ItemOnline) {
+			endpoints = append(endpoints, srv.Endpoint)
+		}
+	}
+	return endpoints
+}
+
+// SortServers sorts info.Servers by pool number then endpoint, and the
+// disks of each server by pool, set and disk index, so that snapshots
+// can be compared. The sort is stable.
+func (info *InfoMessage) SortServers() {
+	sort.SliceStable(info.Servers, func(i, j int) bool {
+		si, sj := info.Servers[i], info.Servers[j]
+		if si.PoolNumber != sj.PoolNumber {
+			return si.PoolNumber < sj.PoolNumber
+		}
+		return si.Endpoint < sj.Endpoint
+	})
+	for _, srv := range info.Servers {
+		sort.SliceStable(srv.Disks, func(i, j int) bool {
+			di, dj := srv.Disks[i], srv.Disks[j]
+			if di.PoolIndex != dj.PoolIndex {
+				return di.PoolIndex < dj.PoolIndex
+			}
+			if di.SetIndex != dj.SetIndex {
+				return di.SetIndex < dj.SetIndex
+			}
+			return di.DiskIndex < dj.DiskIndex
+		})
+	}
+}
+
+// ServerCapacityShares returns each server's fraction of the total raw
+// capacity of online servers, summed from its disks. Offline servers are
+// reported with a share of zero.
+func (info InfoMessage) ServerCapacityShares() map[string]float64 {
+	capacity := make(map[string]uint64, len(info.Servers))
+	var total uint64
+	for _, srv := range info.Servers {
+		if _, ok := capacity[srv.Endpoint]; !ok {
+			capacity[srv.Endpoint] = 0
+		}
+		if ItemState(srv.State) != ItemOnline {
+			continue
+		}
+		for _, disk := range srv.Disks {
+			capacity[srv.Endpoint] += disk.TotalSpace
+			total += disk.TotalSpace
+		}
+	}
+
+	shares := make(map[string]float64, len(capacity))
+	for endpoint, c := range capacity {
+		shares[endpoint] = 0
+		if total > 0 {
+			shares[endpoint] = float64(c) / float64(total)
+		}
+	}
+	return shares
+}
+
+// NodeCapacity holds the space and drive counts of a server.
+//
+//msgp:ignore NodeCapacity
+type NodeCapacity struct {
+	TotalSpace     uint64
+	UsedSpace      uint64
+	AvailableSpace uint64
+	OnlineDisks    int
+	OfflineDisks   int // Drives in any state other than DriveStateOk.
+}
+
+// NodeCapacities returns the space and drive counts of each server, keyed
+// by endpoint. Servers without drives are included with zero values.
+func (info InfoMessage) NodeCapacities() map[string]NodeCapacity {
+	nodes := make(map[string]NodeCapacity, len(info.Servers))
+	for _, srv := range info.Servers {
+		nc := nodes[srv.Endpoint]
+		for _, disk := range srv.Disks {
+			nc.TotalSpace += disk.TotalSpace
+			nc.UsedSpace += disk.UsedSpace
+			nc.AvailableSpace += disk.AvailableSpace
+			if disk.State == DriveStateOk {
+				nc.OnlineDisks++
+			} else {
+				nc.OfflineDisks++
+			}
+		}
+		nodes[srv.Endpoint] = nc
+	}
+	return nodes
+}
+
+// PoolFill holds the raw usage and capacity of a pool.
+//
+//msgp:ignore PoolFill
+type PoolFill struct {
+	Pool     int
+	Used     uint64
+	Capacity uint64
+	Ratio    float64 // Used/Capacity, 0 if Capacity is 0.
+}
+
+// PoolsByFill returns the pools sorted by descending fill ratio,
+// computed from the raw usage and capacity of their erasure sets.
+// Pools with zero capacity are sorted last.
+func (info InfoMessage) PoolsByFill() []PoolFill {
+	pools := make([]PoolFill, 0, len(info.Pools))
+	for idx, sets := range info.Pools {
+		pf := PoolFill{Pool: idx}
+		for _, set := range sets {
+			pf.Used += set.RawUsage
+			pf.Capacity += set.RawCapacity
+		}
+		if pf.Capacity > 0 {
+			pf.Ratio = float64(pf.Used) / float64(pf.Capacity)
+		}
+		pools = append(pools, pf)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		if (pools[i].Capacity == 0) != (pools[j].Capacity == 0) {
+			return pools[j].Capacity == 0
+		}
+		if pools[i].Ratio != pools[j].Ratio {
+			return pools[i].Ratio > pools[j].Ratio
+		}
+		return pools[i].Pool < pools[j].Pool
+	})
+	return pools
+}
+
+// SurvivesPoolLoss returns whether the remaining pools have enough free
+// raw capacity to absorb the raw usage of the given pool. It only checks
+// capacity: objects are not replicated across pools, so the objects stored
+// in a lost pool are gone, and true does not mean that no data is lost.
+// It means that the other pools have room for as much data as the pool
+// holds, for example to rewrite it from a backup. Returns false if the
+// pool is unknown or is the only pool.
+func (info InfoMessage) SurvivesPoolLoss(pool int) bool {
+	if _, ok := info.Pools[pool]; !ok || len(info.Pools) < 2 {
+		return false
+	}
+	var lost, free uint64
+	for _, pf := range info.PoolsByFill() {
+		if pf.Pool == pool {
+			lost = pf.Used
+			continue
+		}
+		if pf.Capacity > pf.Used {
+			free += pf.Capacity - pf.Used
+		}
+	}
+	return free >= lost
+}
+
+// LatestGC returns the most recent garbage collection time across all
+// servers, along with the endpoint of the server that reported it.
+// Servers without GC stats are skipped.
+func (info InfoMessage) LatestGC() (time.Time, string) {
+	var latest time.Time
+	var endpoint string
+	for _, srv := range info.Servers {
+		if srv.GCStats == nil {
+			continue
+		}
+		if srv.GCStats.LastGC.After(latest) {
+			latest, endpoint = srv.GCStats.LastGC, srv.Endpoint
+		}
+	}
+	return latest, endpoint
+}
+
+// LicenseExpiresIn returns the time from now until the earliest license
+// expiry reported by the servers, negative if a license has expired, and
+// whether any server reported a license. Licenses without an expiry are
+// skipped.
+func (info InfoMessage) LicenseExpiresIn(now time.Time) (time.Duration, bool) {
+	var earliest time.Time
+	for _, srv := range info.Servers {
+		if srv.License == nil || srv.License.ExpiresAt.IsZero() {
+			continue
+		}
+		if earliest.IsZero() || srv.License.ExpiresAt.Before(earliest) {
+			earliest = srv.License.ExpiresAt
+		}
+	}
+	if earliest.IsZero() {
+		return 0, false
+	}
+	return earliest.Sub(now), true
+}
+
+// PoolPhase describes the operational phase of a pool.
+type PoolPhase string
+
+const (
+	// PoolPhaseActive indicates the pool is serving reads and writes
+	PoolPhaseActive = PoolPhase("active")
+	// PoolPhaseDraining indicates the pool is being decommissioned
+	PoolPhaseDraining = PoolPhase("draining")
+	// PoolPhaseEmpty indicates the pool holds no data, for example after decommissioning
+	PoolPhaseEmpty = PoolPhase("empty")
+)
+
+// PoolState holds an operational overview of a pool.
+//
+//msgp:ignore PoolState
+type PoolState struct {
+	Pool     int
+	Sets     int
+	Drives   int
+	Used     uint64
+	Capacity uint64
+	Phase    PoolPhase
+}
+
+// PoolSummary returns an overview of every pool sorted by pool index.
+// Pools without raw usage are reported as empty, and pools whose servers
+// are all draining or cordoned are reported as draining.
+func (info InfoMessage) PoolSummary() []PoolState {
+	pools := make([]PoolState, 0, len(info.Pools))
+	for idx, sets := range info.Pools {
+		ps := PoolState{Pool: idx, Sets: len(sets), Phase: PoolPhaseActive}
+		if idx < len(info.Backend.TotalSets) && idx < len(info.Backend.DrivesPerSet) {
+			ps.Sets = info.Backend.TotalSets[idx]
+			ps.Drives = ps.Sets * info.Backend.DrivesPerSet[idx]
+		}
+		for _, set := range sets {
+			ps.Used += set.RawUsage
+			ps.Capacity += set.RawCapacity
+		}
+
+		var servers, draining int
+		for _, srv := range info.Servers {
+			if !slices.Contains(srv.PoolNumbers, idx) && (len(srv.PoolNumbers) > 0 || srv.PoolNumber != idx) {
+				continue
+			}
+			servers++
+			if ItemState(srv.State) == ItemDraining || ItemState(srv.State) == ItemCordoned {
+				draining++
+			}
+		}
+
+		switch {
+		case ps.Used == 0:
+			ps.Phase = PoolPhaseEmpty
+		case servers > 0 && draining == servers:
+			ps.Phase = PoolPhaseDraining
+		}
+		pools = append(pools, ps)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].Pool < pools[j].Pool
+	})
+	return pools
+}
+
+// PlacementHint predicts the pool, erasure set and drives a new object
+// would be written to, mirroring the SIPMOD+PARITY distribution algorithm
+// (erasure format V3) used by MinIO servers. Drives are returned in erasure
+// shard order, data shards first; drives with unknown endpoints are empty.
+//
+// New objects are placed in the pool with the most free space, so for
+// deployments with more than one pool the pool with the most free raw
+// capacity is returned as a best guess. Existing objects remain in the
+// pool they were written to. ok is false if the message lacks a valid
+// deployment ID or erasure set layout.
+func (info InfoMessage) PlacementHint(bucket, object string) (pool int, set int, disks []string, ok bool) {
+	id, err := uuid.Parse(info.DeploymentID)
+	if err != nil || len(info.Pools) == 0 {
+		return -1, -1, nil, false
+	}
+
+	pool = -1
+	var maxFree uint64
+	for idx, sets := range info.Pools {
+		var free uint64
+		for _, set := range sets {
+			if set.RawCapacity > set.RawUsage {
+				free += set.RawCapacity - set.RawUsage
+			}
+		}
+		if pool < 0 || free > maxFree || free == maxFree && idx < pool {
+			pool, maxFree = idx, free
+		}
+	}
+
+	setCount := len(info.Pools[pool])
+	if pool < len(info.Backend.TotalSets) {
+		setCount = info.Backend.TotalSets[pool]
+	}
+	if pool >= len(info.Backend.DrivesPerSet) || info.Backend.DrivesPerSet[pool] <= 0 || setCount <= 0 {
+		return -1, -1, nil, false
+	}
+	drivesPerSet := info.Backend.DrivesPerSet[pool]
+
+	k0, k1 := binary.LittleEndian.Uint64(id[0:8]), binary.LittleEndian.Uint64(id[8:16])
+	set = int(sipHash24(k0, k1, []byte(object)) % uint64(setCount))
+
+	setDisks := make([]string, drivesPerSet)
+	for _, srv := range info.Servers {
+		for _, disk := range srv.Disks {
+			if disk.PoolIndex == pool && disk.SetIndex == set && disk.DiskIndex >= 0 && disk.DiskIndex < drivesPerSet {
+				setDisks[disk.DiskIndex] = disk.Endpoint
+			}
+		}
+	}
+
+	objectPath := path.Join(bucket, object)
+	if strings.HasSuffix(object, "/") {
+		objectPath += "/"
+	}
+	disks = make([]string, drivesPerSet)
+	for i, shard := range hashOrder(objectPath, drivesPerSet) {
+		disks[shard-1] = setDisks[i]
+	}
+	return pool, set, disks, true
+}
+
+// hashOrder returns the 1-based erasure shard index of each drive in a
+// set of cardinality drives for the given object path.
+func hashOrder(key string, cardinality int) []int {
+	nums := make([]int, cardinality)
+	start := int(crc32.ChecksumIEEE([]byte(key)) % uint32(cardinality))
+	for i := 1; i <= cardinality; i++ {
+		nums[i-1] = 1 + ((start + i) % cardinality)
+	}
+	return nums
+}
+
+// sipHash24 returns the SipHash-2-4 of msg keyed by k0 and k1.
+func sipHash24(k0, k1 uint64, msg []byte) uint64 {
+	v0 := k0 ^ 0x736f6d6570736575
+	v1 := k1 ^ 0x646f72616e646f6d
+	v2 := k0 ^ 0x6c7967656e657261
+	v3 := k1 ^ 0x7465646279746573
+
+	round := func() {
+		v0 += v1
+		v1 = bits.RotateLeft64(v1, 13) ^ v0
+		v0 = bits.RotateLeft64(v0, 32)
+		v2 += v3
+		v3 = bits.RotateLeft64(v3, 16) ^ v2
+		v0 += v3
+		v3 = bits.RotateLeft64(v3, 21) ^ v0
+		v2 += v1
+		v1 = bits.RotateLeft64(v1, 17) ^ v2
+		v2 = bits.RotateLeft64(v2, 32)
+	}
+	compress := func(m uint64) {
+		v3 ^= m
+		round()
+		round()
+		v0 ^= m
+	}
+
+	n := len(msg)
+	for ; len(msg) >= 8; msg = msg[8:] {
+		compress(binary.LittleEndian.Uint64(msg))
+	}
+	var last [8]byte
+	copy(last[:], msg)
+	last[7] = byte(n)
+	compress(binary.LittleEndian.Uint64(last[:]))
+
+	v2 ^= 0xff
+	for range 4 {
+		round()
+	}
+	return v0 ^ v1 ^ v2 ^ v3
+}
+
+// Services contains different services information
+type Services struct {
+	KMS           KMS                           `json:"kms,omitempty"` // deprecated july 2023
+	KMSStatus     []KMS                         `json:"kmsStatus,omitempty"`
+	LDAP          LDAP                          `json:"ldap,omitempty"`
+	LDAPStatus    map[string]LDAP               `json:"ldapStatus,omitempty"`
+	Logger        []Logger                      `json:"logger,omitempty"`
+	Audit         []Audit                       `json:"audit,omitempty"`
+	Notifications []map[string][]TargetIDStatus `json:"notifications,omitempty"`
+}
+
+// ListNotificationARNs return a list of configured notification ARNs
+func (s Services) ListNotificationARNs() (arns []ARN) {
+	for _, notify := range s.Notifications {
+		for targetType, targetStatuses := range notify {
+			for _, targetStatus := range targetStatuses {
+				for targetID := range targetStatus {
+					arns = append(arns, ARN{
+						Type:     "sqs",
+						ID:       targetID,
+						Resource: targetType,
+					})
+				}
+			}
+		}
+	}
+	return arns
+}
+
+// Buckets contains the number of buckets
+//
+//msgp:ignore Buckets
+type Buckets struct {
+	Count uint64 `json:"count"`
+	Error string `json:"error,omitempty"`
+
+	// Reported is set when decoded from a response including it.
+	// Producers set it to send a zero value.
+	Reported bool `json:"-"`
+}
+
+// UnmarshalJSON sets Reported when the value is present.
+func (v *Buckets) UnmarshalJSON(data []byte) error {
+	type buckets Buckets
+	return unmarshalReported(data, (*buckets)(v), &v.Reported)
+}
+
+// IsZero returns true if the value was neither reported nor set, in which
+// case it is omitted when encoding an InfoMessage.
+func (v Buckets) IsZero() bool {
+	return !v.Reported && v.Count == 0 && v.Error == ""
+}
+
+// Objects contains the number of objects
+//
+//msgp:ignore Objects
+type Objects struct {
+	Count uint64 `json:"count"`
+	Error string `json:"error,omitempty"`
+
+	// Reported is set when decoded from a response including it.
+	// Producers set it to send a zero value.
+	Reported bool `json:"-"`
+}
+
+// UnmarshalJSON sets Reported when the value is present.
+func (v *Objects) UnmarshalJSON(data []byte) error {
+	type objects Objects
+	return unmarshalReported(data, (*objects)(v), &v.Reported)
+}
+
+// IsZero returns true if the value was neither reported nor set, in which
+// case it is omitted when encoding an InfoMessage.
+func (v Objects) IsZero() bool {
+	return !v.Reported && v.Count == 0 && v.Error == ""
+}
+
+// Versions contains the number of versions
+//
+//msgp:ignore Versions
+type Versions struct {
+	Count uint64 `json:"count"`
+	Error string `json:"error,omitempty"`
+
+	// Reported is set when decoded from a response including it.
+	// Producers set it to send a zero value.
+	Reported bool `json:"-"`
+}
+
+// UnmarshalJSON sets Reported when the value is present.
+func (v *Versions) UnmarshalJSON(data []byte) error {
+	type versions Versions
+	return unmarshalReported(data, (*versions)(v), &v.Reported)
+}
+
+// IsZero returns true if the value was neither reported nor set, in which
+// case it is omitted when encoding an InfoMessage.
+func (v Versions) IsZero() bool {
+	return !v.Reported && v.Count == 0 && v.Error == ""
+}
+
+// DeleteMarkers contains the number of delete markers
+//
+//msgp:ignore DeleteMarkers
+type DeleteMarkers struct {
+	Count uint64 `json:"count"`
+	Error string `json:"error,omitempty"`
+
+	// Reported is set when decoded from a response including it.
+	// Producers set it to send a zero value.
+	Reported bool `json:"-"`
+}
+
+// UnmarshalJSON sets Reported when the value is present.
+func (v *DeleteMarkers) UnmarshalJSON(data []byte) error {
+	type deleteMarkers DeleteMarkers
+	return unmarshalReported(data, (*deleteMarkers)(v), &v.Reported)
+}
+
+// IsZero returns true if the value was neither reported nor set, in which
+// case it is omitted when encoding an InfoMessage.
+func (v DeleteMarkers) IsZero() bool {
+	return !v.Reported && v.Count == 0 && v.Error == ""
+}
+
+// Usage contains the total size used
+//
+//msgp:ignore Usage
+type Usage struct {
+	Size  uint64 `json:"size"`
+	Error string `json:"error,omitempty"`
+
+	// Reported is set when decoded from a response including it.
+	// Producers set it to send a zero value.
+	Reported bool `json:"-"`
+}
+
+// UnmarshalJSON sets Reported when the value is present.
+func (v *Usage) UnmarshalJSON(data []byte) error {
+	type usage Usage
+	return unmarshalReported(data, (*usage)(v), &v.Reported)
+}
+
+// IsZero returns true if the value was neither reported nor set, in which
+// case it is omitted when encoding an InfoMessage.
+func (v Usage) IsZero() bool {
+	return !v.Reported && v.Size == 0 && v.Error == ""
+}
+
+// unmarshalReported decodes data into v and sets reported, unless data is null.
+func unmarshalReported(data []byte, v any, reported *bool) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	*reported = true
+	return nil
+}
+
+// TierStats contains per-tier statistics like total size, number of
+// objects/versions transitioned, etc.
+type TierStats struct {
+	TotalSize   uint64 `json:"totalSize"`
+	NumVersions int    `json:"numVersions"`
+	NumObjects  int    `json:"numObjects"`
+}
+
+// TotalTiered returns the sum of the stats of all tiers.
+func (d DataUsageInfo) TotalTiered() (total TierStats) {
+	for _, st := range d.TierStats {
+		total.TotalSize += st.TotalSize
+		total.NumVersions += st.NumVersions
+		total.NumObjects += st.NumObjects
+	}
+	return total
+}
+
+// TierTransitionRates returns the rate, in bytes per second, at which data
+// was transitioned to each tier between two usage snapshots taken elapsed
+// apart. Tiers that shrank, for example due to restores or expiry, report
+// a rate of zero. Returns an empty map if elapsed is not positive.
+func TierTransitionRates(prev, cur DataUsageInfo, elapsed time.Duration) map[string]float64 {
+	rates := make(map[string]float64, len(cur.TierStats))
+	if elapsed <= 0 {
+		return rates
+	}
+	for tier, stats := range cur.TierStats {
+		var rate float64
+		if before := prev.TierStats[tier].TotalSize; stats.TotalSize > before {
+			rate = float64(stats.TotalSize-before) / elapsed.Seconds()
+		}
+		rates[tier] = rate
+	}
+	return rates
+}
+
+// ReplicationThroughput returns the rate, in bytes per second, at which
+// data was replicated between two usage snapshots taken elapsed apart,
+// along with the fraction, between 0 and 1, of the replicated and failed
+// bytes that were replicated successfully. As DataUsageInfo has no count
+// of replicated objects to weigh ReplicationFailedCount against, the
+// success rate is computed from ReplicationFailedSize, not from the failed
+// counts. Counters that went backwards, for example after a restart, count
+// as zero. The success rate is 1 when there was no replication activity.
+func ReplicationThroughput(prev, cur DataUsageInfo, elapsed time.Duration) (bytesPerSec, successRate float64) {
+	delta := func(cur, prev uint64) uint64 {
+		if cur < prev {
+			return 0
+		}
+		return cur - prev
+	}
+	replicated := delta(cur.ReplicatedSize, prev.ReplicatedSize)
+	failed := delta(cur.ReplicationFailedSize, prev.ReplicationFailedSize)
+	if elapsed > 0 {
+		bytesPerSec = float64(replicated) / elapsed.Seconds()
+	}
+	successRate = 1
+	if replicated+failed > 0 {
+		successRate = float64(replicated) / float64(replicated+failed)
+	}
+	return bytesPerSec, successRate
+}
+
+// KMS contains KMS status information
 type KMS struct {
 	Status   string `json:"status,omitempty"`
 	Encrypt  string `json:"encrypt,omitempty"`
@@ -428,6 +2864,79 @@ type ErasureBackend struct {
 	DrivesPerSet []int `json:"totalDrivesPerSet"`
 }
 
+// DriveCountConsistent reports whether the online and offline drive
+// counts add up to the number of drives configured across all erasure
+// sets. A mismatch suggests a reporting bug or unformatted drives.
+func (e ErasureBackend) DriveCountConsistent() (ok bool, configured, reported int) {
+	for i, sets := range e.TotalSets {
+		if i < len(e.DrivesPerSet) {
+			configured += sets * e.DrivesPerSet[i]
+		}
+	}
+	reported = e.OnlineDisks + e.OfflineDisks
+	return configured == reported, configured, reported
+}
+
+// StandardSCData returns the number of data drives per erasure set of
+// each pool, under the standard storage class. Pools with an invalid
+// parity, see StandardSCDataErr, are reported with zero data drives.
+func (e ErasureBackend) StandardSCData() []int {
+	data := make([]int, len(e.DrivesPerSet))
+	for i, drives := range e.DrivesPerSet {
+		if e.validParity(i) == nil {
+			data[i] = drives - e.StandardSCParity
+		}
+	}
+	return data
+}
+
+// StandardSCDataErr is like StandardSCData, but returns an error if the
+// parity of any pool is negative or not less than its drives per set.
+func (e ErasureBackend) StandardSCDataErr() ([]int, error) {
+	for i := range e.DrivesPerSet {
+		if err := e.validParity(i); err != nil {
+			return nil, err
+		}
+	}
+	return e.StandardSCData(), nil
+}
+
+// CanToleratePoolFailures returns the number of drive failures each
+// erasure set of each pool can tolerate under the standard storage
+// class, which is its parity. Pools with an invalid parity, see
+// CanToleratePoolFailuresErr, are reported as tolerating no failures.
+func (e ErasureBackend) CanToleratePoolFailures() []int {
+	tolerance := make([]int, len(e.DrivesPerSet))
+	for i := range e.DrivesPerSet {
+		if e.validParity(i) == nil {
+			tolerance[i] = e.StandardSCParity
+		}
+	}
+	return tolerance
+}
+
+// CanToleratePoolFailuresErr is like CanToleratePoolFailures, but returns
+// an error if the parity of any pool is negative or not less than its
+// drives per set.
+func (e ErasureBackend) CanToleratePoolFailuresErr() ([]int, error) {
+	for i := range e.DrivesPerSet {
+		if err := e.validParity(i); err != nil {
+			return nil, err
+		}
+	}
+	return e.CanToleratePoolFailures(), nil
+}
+
+// validParity checks the standard storage class parity against the
+// drives per set of the given pool.
+func (e ErasureBackend) validParity(pool int) error {
+	drives := e.DrivesPerSet[pool]
+	if e.StandardSCParity < 0 || e.StandardSCParity >= drives {
+		return fmt.Errorf("pool %d: invalid parity %d for %d drives per set", pool, e.StandardSCParity, drives)
+	}
+	return nil
+}
+
 // Version represents a semantic version
 type Version struct {
 	Major uint16 `json:"major"`
@@ -460,6 +2969,7 @@ type ServerProperties struct {
 	PoolNumber          int               `json:"poolNumber,omitempty"` // Only set if len(PoolNumbers) == 1
 	PoolNumbers         []int             `json:"poolNumbers,omitempty"`
 	MemStats            MemStats          `json:"mem_stats"`
+	GCStats             *GCStats          `json:"gc_stats,omitempty"`
 	GoMaxProcs          int               `json:"go_max_procs,omitempty"`
 	NumCPU              int               `json:"num_cpu,omitempty"`
 	RuntimeVersion      string            `json:"runtime_version,omitempty"`
@@ -481,6 +2991,19 @@ type ServerProperties struct {
 	RestartingSince time.Time  `json:"restarting_since,omitempty"`
 }
 
+// UptimeDuration returns the uptime of the server, or 0 if unknown.
+func (s ServerProperties) UptimeDuration() time.Duration {
+	if s.Uptime <= 0 {
+		return 0
+	}
+	return time.Duration(s.Uptime) * time.Second
+}
+
+// StartedAt returns the time the server started, relative to now.
+func (s ServerProperties) StartedAt(now time.Time) time.Time {
+	return now.Add(-s.UptimeDuration())
+}
+
 // MemStats is strip down version of runtime.MemStats containing memory stats of MinIO server.
 type MemStats struct {
 	Alloc      uint64
@@ -490,6 +3013,23 @@ type MemStats struct {
 	HeapAlloc  uint64
 }
 
+// LiveObjects returns the number of live heap objects, Mallocs - Frees.
+func (m MemStats) LiveObjects() uint64 {
+	if m.Frees > m.Mallocs {
+		return 0
+	}
+	return m.Mallocs - m.Frees
+}
+
+// HeapUtilization returns HeapAlloc as a fraction of Alloc, or 0 if
+// Alloc is 0.
+func (m MemStats) HeapUtilization() float64 {
+	if m.Alloc == 0 {
+		return 0
+	}
+	return float64(m.HeapAlloc) / float64(m.Alloc)
+}
+
 // GCStats collect information about recent garbage collections.
 type GCStats struct {
 	LastGC     time.Time       `json:"last_gc"`     // time of last collection
@@ -499,10 +3039,55 @@ type GCStats struct {
 	PauseEnd   []time.Time     `json:"pause_end"`   // pause end times history, most recent first
 }
 
+// RecentPauseStats returns the longest and average pause of the most
+// recent window collections, or of all collections in the history if
+// fewer. Zero is returned if there are none.
+func (g *GCStats) RecentPauseStats(window int) (maxPause, avgPause time.Duration) {
+	if g == nil || window <= 0 || len(g.Pause) == 0 {
+		return 0, 0
+	}
+	recent := g.Pause[:min(window, len(g.Pause))]
+	var total time.Duration
+	for _, p := range recent {
+		maxPause = max(maxPause, p)
+		total += p
+	}
+	return maxPause, total / time.Duration(len(recent))
+}
+
+// PauseSince returns the total pause of the collections in the history
+// that ended after t.
+func (g *GCStats) PauseSince(t time.Time) (total time.Duration) {
+	if g == nil {
+		return 0
+	}
+	for i := range min(len(g.Pause), len(g.PauseEnd)) {
+		if g.PauseEnd[i].After(t) {
+			total += g.Pause[i]
+		}
+	}
+	return total
+}
+
+// NormalizeTimes converts all time fields of g to UTC.
+func (g *GCStats) NormalizeTimes() {
+	if g == nil {
+		return
+	}
+	g.LastGC = g.LastGC.UTC()
+	for i, t := range g.PauseEnd {
+		g.PauseEnd[i] = t.UTC()
+	}
+}
+
 // DiskStatus has the information about XL Storage APIs
 // the number of calls of each API and the moving average of
 // the duration, in nanosecond, of each API.
 type DiskStatus struct {
+	// Deprecated: TotalTokens is no longer reported by the server,
+	// it is only decoded from older servers.
+	TotalTokens uint32 `json:"totalTokens,omitempty"`
+
 	// TotalWaiting is something. Seems to be related to offline disks.
 	TotalWaiting uint32 `json:"totalWaiting,omitempty"`
 
@@ -512,6 +3097,71 @@ type DiskStatus struct {
 
 	// Captures all timeout only errors
 	TotalErrorsTimeout uint64 `json:"totalErrorsTimeout,omitempty"`
+
+	// Number of calls of each API since the server started.
+	APICalls map[string]uint64 `json:"apiCalls,omitempty"`
+
+	// Calls of each API over the last minute.
+	LastMinute map[string]TimedAction `json:"lastMinute,omitempty"`
+}
+
+// UnmarshalJSON decodes the disk metrics, tolerating the deprecated
+// TotalTokens field being sent as a string or a floating point number
+// by older servers. Values that cannot be interpreted are ignored.
+func (d *DiskStatus) UnmarshalJSON(data []byte) error {
+	type diskStatus DiskStatus
+	var v struct {
+		diskStatus
+		TotalTokens any `json:"totalTokens,omitempty"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*d = DiskStatus(v.diskStatus)
+	switch tokens := v.TotalTokens.(type) {
+	case float64:
+		if tokens >= 0 && tokens <= math.MaxUint32 {
+			d.TotalTokens = uint32(tokens)
+		}
+	case string:
+		if n, err := strconv.ParseUint(tokens, 10, 32); err == nil {
+			d.TotalTokens = uint32(n)
+		}
+	}
+	return nil
+}
+
+// IsLegacyMetrics returns true if the metrics look like they were sent by
+// an older server, reporting TotalTokens but none of the newer fields.
+func (d DiskStatus) IsLegacyMetrics() bool {
+	return d.TotalTokens > 0 && d.TotalWaiting == 0 &&
+		d.TotalErrorsAvailability == 0 && d.TotalErrorsTimeout == 0 &&
+		len(d.APICalls) == 0 && len(d.LastMinute) == 0
+}
+
+// APILatency holds the average duration of calls to a drive API.
+//
+//msgp:ignore APILatency
+type APILatency struct {
+	API         string
+	AvgDuration time.Duration
+	Count       uint64
+}
+
+// SlowestAPIs returns up to n APIs with the highest average duration over
+// the last minute, slowest first. Ties are ordered by API name.
+func (d DiskStatus) SlowestAPIs(n int) []APILatency {
+	apis := make([]APILatency, 0, len(d.LastMinute))
+	for api, action := range d.LastMinute {
+		apis = append(apis, APILatency{API: api, AvgDuration: action.Avg(), Count: action.Count})
+	}
+	sort.Slice(apis, func(i, j int) bool {
+		if apis[i].AvgDuration != apis[j].AvgDuration {
+			return apis[i].AvgDuration > apis[j].AvgDuration
+		}
+		return apis[i].API < apis[j].API
+	})
+	return apis[:min(max(n, 0), len(apis))]
 }
 
 // CacheStats drive cache stats
@@ -544,6 +3194,32 @@ func (c *CacheStats) Merge(other *CacheStats) {
 	c.Collisions += other.Collisions
 }
 
+// HitRatio returns the percentage, between 0 and 100, of cache lookups
+// that were hits, or 0 if there were none. See
+// StorageInfo.ClusterCacheHitRatio for a ratio between 0 and 1.
+func (c CacheStats) HitRatio() float64 {
+	return cacheRatio(c.Hits, c.Hits+c.Misses)
+}
+
+// DelHitRatio returns the percentage, between 0 and 100, of cache
+// deletes that were hits, or 0 if there were none.
+func (c CacheStats) DelHitRatio() float64 {
+	return cacheRatio(c.DelHits, c.DelHits+c.DelMisses)
+}
+
+// UsedPercent returns the percentage, between 0 and 100, of the cache
+// capacity in use, or 0 if the capacity is unknown.
+func (c CacheStats) UsedPercent() float64 {
+	return cacheRatio(c.Used, c.Capacity)
+}
+
+func cacheRatio(n, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
+
 // Disk holds Disk information
 type Disk struct {
 	Endpoint        string       `json:"endpoint,omitempty"`
@@ -572,6 +3248,7 @@ type Disk struct {
 	FreeInodes      uint64       `json:"free_inodes,omitempty"`
 	Local           bool         `json:"local,omitempty"`
 	Cache           *CacheStats  `json:"cacheStats,omitempty"`
+	Metadata        bool         `json:"metadata,omitempty"` // Drive dedicated to metadata, if reported.
 
 	// Indexes, will be -1 until assigned a set.
 	PoolIndex int `json:"pool_index"`
@@ -579,10 +3256,145 @@ type Disk struct {
 	DiskIndex int `json:"disk_index"`
 }
 
+// UsedPercent returns the used space of the disk as a percentage of its
+// total space, or 0 if the total space is unknown.
+func (d Disk) UsedPercent() float64 {
+	if d.TotalSpace == 0 {
+		return 0
+	}
+	return float64(d.UsedSpace) * 100 / float64(d.TotalSpace)
+}
+
+// InodeUsedPercent returns the used inodes of the disk as a percentage of
+// all its inodes, or 0 if the inode counts are unknown.
+func (d Disk) InodeUsedPercent() float64 {
+	total := d.UsedInodes + d.FreeInodes
+	if total == 0 {
+		return 0
+	}
+	return float64(d.UsedInodes) * 100 / float64(total)
+}
+
+// ReadMBps returns the read throughput of the disk in MiB/s.
+func (d Disk) ReadMBps() float64 {
+	return d.ReadThroughput / humanize.MiByte
+}
+
+// WriteMBps returns the write throughput of the disk in MiB/s.
+func (d Disk) WriteMBps() float64 {
+	return d.WriteThroughPut / humanize.MiByte
+}
+
+// TotalThroughputMBps returns the combined read and write throughput of
+// the disk in MiB/s.
+func (d Disk) TotalThroughputMBps() float64 {
+	return d.ReadMBps() + d.WriteMBps()
+}
+
+// IdentityKey returns a key identifying the disk across snapshots: its
+// UUID when known, otherwise its endpoint and its path separated by a NUL
+// byte, which occurs in neither, so disks can be matched before a UUID is
+// assigned.
+func (d Disk) IdentityKey() string {
+	if d.UUID != "" {
+		return d.UUID
+	}
+	return d.Endpoint + "\x00" + d.DrivePath
+}
+
+// DriveMediaType is the storage medium of a drive.
+type DriveMediaType string
+
+const (
+	// MediaUnknown indicates the medium of the drive could not be inferred
+	MediaUnknown = DriveMediaType("unknown")
+	// MediaSSD indicates a solid state drive
+	MediaSSD = DriveMediaType("ssd")
+	// MediaHDD indicates a rotational hard drive
+	MediaHDD = DriveMediaType("hdd")
+)
+
+// Heuristics used by Disk.MediaType, which may be adjusted to match the
+// hardware in use.
+var (
+	// SSDModelHints are case-insensitive model substrings of solid state drives.
+	SSDModelHints = []string{"nvme", "ssd", "flash"}
+	// SSDMaxReadLatency is the average read latency over the last minute
+	// at or below which a drive is assumed to be a solid state drive.
+	SSDMaxReadLatency = time.Millisecond
+	// HDDMinReadLatency is the average read latency over the last minute
+	// at or above which a drive is assumed to be a hard drive.
+	HDDMinReadLatency = 5 * time.Millisecond
+)
+
+// MediaType returns a best effort guess of the medium of the drive,
+// based on its model and, failing that, the average latency of its read
+// calls over the last minute, as found in Metrics.LastMinute. These are
+// only reported when requested with WithDriveMetrics. The server does not
+// report whether a drive is rotational, nor do hard drive models follow a
+// common naming, so hard drives are only recognized by their latency.
+// MediaUnknown is returned when neither is conclusive.
+func (d Disk) MediaType() DriveMediaType {
+	model := strings.ToLower(d.Model)
+	if model != "" && slices.ContainsFunc(SSDModelHints, func(hint string) bool {
+		return strings.Contains(model, strings.ToLower(hint))
+	}) {
+		return MediaSSD
+	}
+	if d.Metrics == nil {
+		return MediaUnknown
+	}
+	var reads TimedAction
+	for api, action := range d.Metrics.LastMinute {
+		if strings.HasPrefix(api, "Read") {
+			reads.Merge(action)
+		}
+	}
+	switch {
+	case reads.Count == 0:
+		return MediaUnknown
+	case reads.Avg() <= SSDMaxReadLatency:
+		return MediaSSD
+	case reads.Avg() >= HDDMinReadLatency:
+		return MediaHDD
+	default:
+		return MediaUnknown
+	}
+}
+
 // ServerInfoOpts ask for additional data from the server
 type ServerInfoOpts struct {
 	Uncached bool
 	Metrics  bool
+
+	// Only return the requested pool, and set within it, in InfoMessage.Pools.
+	FilterPool bool
+	FilterSet  bool
+	Pool       int
+	Set        int
+
+	// Only return the requested hosts in InfoMessage.Servers.
+	Hosts []string
+
+	// Ask for a msgpack encoded response.
+	Msgpack bool
+
+	// Number of attempts, and initial backoff between them, for calls
+	// failing with a transient error.
+	RetryAttempts int
+	RetryBase     time.Duration
+
+	// Only accept a response from this deployment.
+	DeploymentID string
+
+	// Fail on JSON fields unknown to InfoMessage.
+	DisallowUnknownFields bool
+
+	// Redact the values of sensitive environment variables.
+	RedactEnv bool
+
+	// Revalidate the response cached here, if any, with the server.
+	Cache *ServerInfoCache `json:"-"`
 }
 
 // WithDriveMetrics asks server to return additional metrics per drive
@@ -599,42 +3411,652 @@ func Uncached() func(*ServerInfoOpts) {
 	}
 }
 
+// WithPoolFilter limits InfoMessage.Pools to the given pool
+func WithPoolFilter(pool int) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.FilterPool = true
+		opts.Pool = pool
+	}
+}
+
+// WithSetFilter limits InfoMessage.Pools to the given erasure set of a pool
+func WithSetFilter(pool, set int) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.FilterPool = true
+		opts.FilterSet = true
+		opts.Pool = pool
+		opts.Set = set
+	}
+}
+
+// WithRetry retries ServerInfo calls failing with a 500 status, or because
+// the connection was refused, as by a node restarting, up to attempts
+// times in total. The backoff between attempts starts at base and doubles
+// after each attempt. Other network errors and the statuses retried by
+// every call, like 502 and 503, are not retried again. Other errors are
+// returned immediately. Once retried, the error reports the number of
+// attempts made and wraps the error of the last one.
+func WithRetry(attempts int, base time.Duration) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.RetryAttempts = attempts
+		opts.RetryBase = base
+	}
+}
+
+// WithDeploymentID targets the deployment with the given ID, when
+// several deployments are served behind the same endpoint. ServerInfo
+// returns ErrDeploymentMismatch if another deployment responded.
+func WithDeploymentID(id string) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.DeploymentID = id
+	}
+}
+
+// WithDisallowUnknownFields makes ServerInfo fail when the JSON response
+// holds fields unknown to InfoMessage, naming each of them in the error.
+// This helps detecting schema drift against a given server release.
+// Msgpack responses are not checked.
+func WithDisallowUnknownFields(disallow bool) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.DisallowUnknownFields = disallow
+	}
+}
+
+// ServerInfoCache holds the last InfoMessage returned by ServerInfo and
+// its ETag, if the server sent one. It is safe for concurrent use, and
+// should only be shared by calls made with the same options.
+//
+//msgp:ignore ServerInfoCache
+type ServerInfoCache struct {
+	mu      sync.Mutex
+	etag    string
+	message InfoMessage
+}
+
+func (c *ServerInfoCache) load() (etag string, message InfoMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.etag, c.message
+}
+
+func (c *ServerInfoCache) store(etag string, message InfoMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.etag = etag
+	c.message = message
+}
+
+// ErrNotModified is returned by ServerInfo along with the InfoMessage
+// cached by WithETagCache, when the server reports it is unchanged.
+var ErrNotModified = errors.New("server info not modified")
+
+// WithETagCache makes ServerInfo send the ETag of the response held in
+// cache, if any, and store new responses in it. When the server reports
+// the response is unchanged, ServerInfo returns the cached InfoMessage
+// along with ErrNotModified, saving its download.
+func WithETagCache(cache *ServerInfoCache) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.Cache = cache
+	}
+}
+
+// ErrDeploymentMismatch is returned by ServerInfo when the response is
+// not from the deployment requested with WithDeploymentID.
+var ErrDeploymentMismatch = errors.New("deployment ID mismatch")
+
+// SensitiveEnvVarPatterns lists the substrings, matched case-insensitively,
+// of the names of environment variables redacted by WithRedactEnv.
+var SensitiveEnvVarPatterns = []string{"KEY", "SECRET", "PASSWORD", "TOKEN"}
+
+// WithRedactEnv replaces the values of the environment variables in
+// ServerProperties.MinioEnvVars whose names match any of the
+// SensitiveEnvVarPatterns with "***".
+func WithRedactEnv(redact bool) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.RedactEnv = redact
+	}
+}
+
+// redactEnv redacts the sensitive environment variables of all servers
+// in info, if requested in opts.
+func (opts ServerInfoOpts) redactEnv(info *InfoMessage) {
+	if !opts.RedactEnv {
+		return
+	}
+	for _, srv := range info.Servers {
+		for name := range srv.MinioEnvVars {
+			upper := strings.ToUpper(name)
+			for _, pattern := range SensitiveEnvVarPatterns {
+				if strings.Contains(upper, strings.ToUpper(pattern)) {
+					srv.MinioEnvVars[name] = "***"
+					break
+				}
+			}
+		}
+	}
+}
+
+// WithMsgpack asks the server to send its response msgpack encoded, which
+// is more compact than JSON. JSON responses are still accepted, for
+// servers that do not support msgpack.
+func WithMsgpack(enabled bool) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.Msgpack = enabled
+	}
+}
+
+// WithServerInfoHosts limits InfoMessage.Servers to the given hosts,
+// matched case-insensitively against the host of the server endpoints.
+func WithServerInfoHosts(hosts ...string) func(*ServerInfoOpts) {
+	return func(opts *ServerInfoOpts) {
+		opts.Hosts = append(opts.Hosts, hosts...)
+	}
+}
+
+// PoolNotFoundError is returned by ServerInfo when a pool or erasure set
+// requested with WithPoolFilter or WithSetFilter does not exist.
+//
+//msgp:ignore PoolNotFoundError
+type PoolNotFoundError struct {
+	Pool int
+	Set  int // -1 if no set was requested.
+}
+
+func (e PoolNotFoundError) Error() string {
+	if e.Set < 0 {
+		return fmt.Sprintf("pool %d not found", e.Pool)
+	}
+	return fmt.Sprintf("erasure set %d not found in pool %d", e.Set, e.Pool)
+}
+
+// filterPools trims info.Pools to the pool and set requested in opts.
+func (opts ServerInfoOpts) filterPools(info *InfoMessage) error {
+	if !opts.FilterPool {
+		return nil
+	}
+	sets, ok := info.Pools[opts.Pool]
+	if !ok {
+		set := -1
+		if opts.FilterSet {
+			set = opts.Set
+		}
+		return PoolNotFoundError{Pool: opts.Pool, Set: set}
+	}
+	if opts.FilterSet {
+		setInfo, ok := sets[opts.Set]
+		if !ok {
+			return PoolNotFoundError{Pool: opts.Pool, Set: opts.Set}
+		}
+		sets = map[int]ErasureSetInfo{opts.Set: setInfo}
+	}
+	info.Pools = map[int]map[int]ErasureSetInfo{opts.Pool: sets}
+	return nil
+}
+
+// HostsNotFoundWarning is returned by ServerInfo along with a valid, but
+// empty, InfoMessage.Servers when none of the hosts requested with
+// WithServerInfoHosts are part of the deployment. It is not fatal.
+//
+//msgp:ignore HostsNotFoundWarning
+type HostsNotFoundWarning struct {
+	Hosts []string
+}
+
+func (e HostsNotFoundWarning) Error() string {
+	return fmt.Sprintf("none of the requested hosts %v found", e.Hosts)
+}
+
+// filterHosts trims info.Servers to the hosts requested in opts, in case
+// the server did not apply the filter itself. It returns true if some
+// hosts were requested and none was found.
+func (opts ServerInfoOpts) filterHosts(info *InfoMessage) (noneFound bool) {
+	if len(opts.Hosts) == 0 {
+		return false
+	}
+	servers := info.FilterServers(func(srv ServerProperties) bool {
+		hostPort := srv.Endpoint
+		if u, err := url.Parse(srv.Endpoint); err == nil && u.Host != "" {
+			hostPort = u.Host
+		}
+		host := hostPort
+		if h, _, err := net.SplitHostPort(hostPort); err == nil {
+			host = h
+		}
+		for _, h := range opts.Hosts {
+			if strings.EqualFold(h, host) || strings.EqualFold(h, hostPort) {
+				return true
+			}
+		}
+		return false
+	})
+	if servers == nil {
+		servers = []ServerProperties{}
+	}
+	info.Servers = servers
+	return len(servers) == 0
+}
+
+// applyFilters trims and redacts info as requested in opts. A
+// HostsNotFoundWarning is returned along with the filtered message if
+// none of the requested hosts were found. info is cleared on any other
+// error.
+func (opts ServerInfoOpts) applyFilters(info *InfoMessage) error {
+	if err := opts.filterPools(info); err != nil {
+		*info = InfoMessage{}
+		return err
+	}
+	noneFound := opts.filterHosts(info)
+	opts.redactEnv(info)
+	if noneFound {
+		return HostsNotFoundWarning{Hosts: opts.Hosts}
+	}
+	return nil
+}
+
+// withoutFilters clears the options applied by applyFilters, so that the
+// full response is returned.
+func withoutFilters(opts *ServerInfoOpts) {
+	opts.FilterPool, opts.FilterSet = false, false
+	opts.Hosts = nil
+	opts.RedactEnv = false
+}
+
+const msgpackContentType = "application/msgpack"
+
 // ServerInfo - Connect to a minio server and call Server Admin Info Management API
-// to fetch server's information represented by infoMessage structure
+// to fetch server's information represented by infoMessage structure.
+// A HostsNotFoundWarning is returned along with the InfoMessage if none of
+// the hosts requested with WithServerInfoHosts were found.
+// ErrNotModified is returned along with the cached InfoMessage if the
+// response cached with WithETagCache is still current.
 func (adm *AdminClient) ServerInfo(ctx context.Context, options ...func(*ServerInfoOpts)) (InfoMessage, error) {
+	message, _, err := adm.serverInfo(ctx, false, options...)
+	return message, err
+}
+
+// ServerInfoRaw is like ServerInfo, but also returns the undecoded
+// response body, after decompression. This helps diagnosing fields sent
+// by the server that are not known to InfoMessage.
+func (adm *AdminClient) ServerInfoRaw(ctx context.Context, options ...func(*ServerInfoOpts)) (InfoMessage, []byte, error) {
+	return adm.serverInfo(ctx, true, options...)
+}
+
+func (adm *AdminClient) serverInfo(ctx context.Context, raw bool, options ...func(*ServerInfoOpts)) (InfoMessage, []byte, error) {
 	srvOpts := &ServerInfoOpts{}
 
 	for _, o := range options {
 		o(srvOpts)
 	}
 
+	// The attempt count is only reported once the call was retried.
+	attemptsErr := func(attempt int, err error) error {
+		if attempt == 1 {
+			return err
+		}
+		return fmt.Errorf("server info failed after %d attempts: %w", attempt, err)
+	}
+	for attempt := 1; ; attempt++ {
+		message, body, status, err := adm.serverInfoOnce(ctx, raw, srvOpts)
+		if err == nil || status == http.StatusOK || status == http.StatusNotModified {
+			return message, body, err
+		}
+		// Other transient failures were already retried by executeMethod,
+		// which gives up right away on refused connections.
+		retryable := status == http.StatusInternalServerError ||
+			(status == 0 && errors.Is(err, syscall.ECONNREFUSED) && ctx.Err() == nil)
+		if attempt >= srvOpts.RetryAttempts || !retryable {
+			return InfoMessage{}, nil, attemptsErr(attempt, err)
+		}
+		select {
+		case <-ctx.Done():
+			return InfoMessage{}, nil, attemptsErr(attempt, fmt.Errorf("%w: %w", ctx.Err(), err))
+		case <-time.After(srvOpts.RetryBase << (attempt - 1)):
+		}
+	}
+}
+
+// serverInfoOnce performs a single ServerInfo call, also returning the
+// HTTP status code of the response, or 0 if none was received.
+func (adm *AdminClient) serverInfoOnce(ctx context.Context, raw bool, srvOpts *ServerInfoOpts) (InfoMessage, []byte, int, error) {
 	values := make(url.Values)
 	values.Set("metrics", strconv.FormatBool(srvOpts.Metrics))
 	values.Set("no-cache", strconv.FormatBool(srvOpts.Uncached))
+	if srvOpts.FilterPool {
+		values.Set("pool", strconv.Itoa(srvOpts.Pool))
+	}
+	if srvOpts.FilterSet {
+		values.Set("set", strconv.Itoa(srvOpts.Set))
+	}
+	for _, host := range srvOpts.Hosts {
+		values.Add("host", host)
+	}
+	if srvOpts.DeploymentID != "" {
+		values.Set("deployment", srvOpts.DeploymentID)
+	}
+	headers := acceptGzipHeaders()
+	if srvOpts.Msgpack {
+		headers.Set("Accept", msgpackContentType)
+	}
+	var etag string
+	var cached InfoMessage
+	if srvOpts.Cache != nil {
+		if etag, cached = srvOpts.Cache.load(); etag != "" {
+			headers.Set("If-None-Match", etag)
+		}
+	}
 
 	resp, err := adm.executeMethod(ctx,
 		http.MethodGet,
 		requestData{
-			relPath:     adminAPIPrefix + "/info",
-			queryValues: values,
+			relPath:       adminAPIPrefix + "/info",
+			queryValues:   values,
+			customHeaders: headers,
 		})
 	defer closeResponse(resp)
 	if err != nil {
-		return InfoMessage{}, err
+		return InfoMessage{}, nil, 0, err
 	}
 
 	// Check response http status code
+	if resp.StatusCode == http.StatusNotModified && etag != "" {
+		return cached, nil, resp.StatusCode, ErrNotModified
+	}
 	if resp.StatusCode != http.StatusOK {
-		return InfoMessage{}, httpRespToErrorResponse(resp)
+		return InfoMessage{}, nil, resp.StatusCode, httpRespToErrorResponse(resp)
 	}
 
-	// Unmarshal the server's json response
+	r, err := responseReader(resp)
+	if err != nil {
+		return InfoMessage{}, nil, resp.StatusCode, err
+	}
+
+	// Unmarshal the server's json or msgpack response
 	var message InfoMessage
-	if err = json.NewDecoder(resp.Body).Decode(&message); err != nil {
-		return InfoMessage{}, err
+	var body []byte
+	isMsgpack := strings.HasPrefix(resp.Header.Get("Content-Type"), msgpackContentType)
+	if raw || isMsgpack || srvOpts.DisallowUnknownFields {
+		if body, err = io.ReadAll(r); err != nil {
+			return InfoMessage{}, nil, resp.StatusCode, err
+		}
+		switch {
+		case isMsgpack:
+			_, err = message.UnmarshalMsg(body)
+		case srvOpts.DisallowUnknownFields:
+			err = decodeInfoMessageStrict(body, &message)
+		default:
+			err = json.Unmarshal(body, &message)
+		}
+	} else {
+		err = json.NewDecoder(r).Decode(&message)
+	}
+	if err != nil {
+		return InfoMessage{}, nil, resp.StatusCode, err
+	}
+	if srvOpts.DeploymentID != "" && message.DeploymentID != srvOpts.DeploymentID {
+		return InfoMessage{}, nil, resp.StatusCode, fmt.Errorf("%w: got %q, want %q", ErrDeploymentMismatch, message.DeploymentID, srvOpts.DeploymentID)
+	}
+	message.fetchedAt = time.Now().UTC()
+	message.source = adm.endpointURL.String()
+	err = srvOpts.applyFilters(&message)
+	if err != nil && !errors.As(err, &HostsNotFoundWarning{}) {
+		return InfoMessage{}, nil, resp.StatusCode, err
+	}
+	if srvOpts.Cache != nil {
+		srvOpts.Cache.store(resp.Header.Get("ETag"), message)
+	}
+
+	return message, body, resp.StatusCode, err
+}
+
+// decodeInfoMessageStrict decodes data into message, failing on fields
+// unknown to InfoMessage. The error names every unknown field with its
+// path in the document.
+func decodeInfoMessageStrict(data []byte, message *InfoMessage) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(message)
+	// The decoder stops at the first unknown field, without its path,
+	// and misses those within types decoding themselves.
+	if problems := ValidateInfoMessageJSON(data); len(problems) > 0 {
+		return fmt.Errorf("server info does not match InfoMessage: %s", strings.Join(problems, "; "))
+	}
+	return err
+}
+
+// ServerInfoResult holds the response of ServerInfo from one client of
+// ServerInfoMulti.
+//
+//msgp:ignore ServerInfoResult
+type ServerInfoResult struct {
+	Endpoint string
+	Info     InfoMessage
+	Err      error
+}
+
+// serverInfoMultiWorkers bounds the number of concurrent ServerInfo
+// calls made by ServerInfoMulti.
+const serverInfoMultiWorkers = 8
+
+// ServerInfoMulti calls ServerInfo with the given options on all clients
+// concurrently, and returns the results in the same order as clients.
+// Once ctx is canceled no further calls are started, and the clients not
+// yet called report the context error.
+func ServerInfoMulti(ctx context.Context, clients []*AdminClient, options ...func(*ServerInfoOpts)) []ServerInfoResult {
+	results := make([]ServerInfoResult, len(clients))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(len(clients), serverInfoMultiWorkers) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Info, results[i].Err = clients[i].ServerInfo(ctx, options...)
+			}
+		}()
+	}
+	for i, clnt := range clients {
+		results[i].Endpoint = clnt.endpointURL.String()
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// FullSnapshot bundles the responses of ServerInfo, StorageInfo and
+// DataUsageInfo fetched together, along with the error of each call.
+//
+//msgp:ignore FullSnapshot
+type FullSnapshot struct {
+	ServerInfo    InfoMessage
+	StorageInfo   StorageInfo
+	DataUsageInfo DataUsageInfo
+
+	ServerInfoErr    error
+	StorageInfoErr   error
+	DataUsageInfoErr error
+}
+
+// FullSnapshot fetches ServerInfo, StorageInfo and DataUsageInfo
+// concurrently, bounded by the deadline of ctx. A partial snapshot is
+// returned when some of the calls fail, with the failures recorded in the
+// snapshot. An error is only returned if all calls fail.
+func (adm *AdminClient) FullSnapshot(ctx context.Context) (FullSnapshot, error) {
+	var snapshot FullSnapshot
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		snapshot.ServerInfo, snapshot.ServerInfoErr = adm.ServerInfo(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		snapshot.StorageInfo, snapshot.StorageInfoErr = adm.StorageInfo(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		snapshot.DataUsageInfo, snapshot.DataUsageInfoErr = adm.DataUsageInfo(ctx)
+	}()
+	wg.Wait()
+
+	if snapshot.ServerInfoErr != nil && snapshot.StorageInfoErr != nil && snapshot.DataUsageInfoErr != nil {
+		return snapshot, errors.Join(snapshot.ServerInfoErr, snapshot.StorageInfoErr, snapshot.DataUsageInfoErr)
+	}
+	return snapshot, nil
+}
+
+// ValidateInfoMessageJSON checks a JSON encoded InfoMessage against the
+// InfoMessage schema and returns a description of every unknown field
+// and type mismatch found. Unlike a strict decode it does not stop at
+// the first problem. Values accepted by the custom decoding of a type,
+// such as a string encoded DiskStatus.TotalTokens, are not mismatches.
+// An empty result means the payload matches.
+func ValidateInfoMessageJSON(data []byte) []string {
+	// Walk the document to report every problem, not just the first.
+	// A strict decode would not catch unknown fields within types of this
+	// package that implement json.Unmarshaler.
+	var problems []string
+	validateJSONValue(json.RawMessage(data), infoMessageType, "", false, &problems)
+	return problems
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	infoMessageType     = reflect.TypeOf(InfoMessage{})
+)
+
+// validateJSONValue validates raw against the type t, appending any
+// problems found. path is the location of raw within the document. With
+// unknownOnly set, only unknown fields are reported.
+func validateJSONValue(raw json.RawMessage, t reflect.Type, path string, unknownOnly bool, problems *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if string(raw) == "null" || t.Kind() == reflect.Interface {
+		return
+	}
+
+	kind := t.Kind()
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		if t.PkgPath() != infoMessageType.PkgPath() {
+			// Foreign types with custom decoding are validated as a whole,
+			// the ones of this package decode their fields as usual.
+			kind = reflect.Invalid
+		} else if !unknownOnly && json.Unmarshal(raw, reflect.New(t).Interface()) == nil {
+			// The custom decoding may accept values the types of the
+			// fields do not, such as DiskStatus.TotalTokens as a string.
+			unknownOnly = true
+		}
+	}
+
+	switch {
+	case kind == reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			if !unknownOnly {
+				*problems = append(*problems, jsonProblem(path, err))
+			}
+			return
+		}
+		for _, key := range sortedKeys(fields) {
+			field, ok := jsonField(t, key)
+			if !ok {
+				*problems = append(*problems, fmt.Sprintf("unknown field %q", joinJSONPath(path, key)))
+				continue
+			}
+			validateJSONValue(fields[key], field.Type, joinJSONPath(path, key), unknownOnly, problems)
+		}
+	case kind == reflect.Map:
+		var entries map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			if !unknownOnly {
+				*problems = append(*problems, jsonProblem(path, err))
+			}
+			return
+		}
+		for _, key := range sortedKeys(entries) {
+			validateJSONValue(entries[key], t.Elem(), joinJSONPath(path, key), unknownOnly, problems)
+		}
+	case kind == reflect.Slice && t.Elem().Kind() != reflect.Uint8:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			if !unknownOnly {
+				*problems = append(*problems, jsonProblem(path, err))
+			}
+			return
+		}
+		for i, elem := range elems {
+			validateJSONValue(elem, t.Elem(), fmt.Sprintf("%s[%d]", path, i), unknownOnly, problems)
+		}
+	case !unknownOnly:
+		if err := json.Unmarshal(raw, reflect.New(t).Interface()); err != nil {
+			*problems = append(*problems, jsonProblem(path, err))
+		}
+	}
+}
+
+// jsonField returns the field of struct type t that the JSON key decodes
+// into, matching names the same way encoding/json does.
+func jsonField(t reflect.Type, key string) (reflect.StructField, bool) {
+	var folded *reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if name == key {
+			return field, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = &field
+		}
+	}
+	if folded != nil {
+		return *folded, true
+	}
+	return reflect.StructField{}, false
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonProblem(path string, err error) string {
+	if path == "" {
+		return err.Error()
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("field %q: cannot use JSON %s as %s", path, typeErr.Value, typeErr.Type)
 	}
+	return fmt.Sprintf("field %q: %v", path, err)
+}
 
-	return message, nil
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // NewHostInfoStat creates a new HostInfoStat from a host.InfoStat.
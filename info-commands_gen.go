@@ -531,6 +531,58 @@ func (z *APIVersion) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *AlertSeverity) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = AlertSeverity(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z AlertSeverity) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteString(string(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z AlertSeverity) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendString(o, string(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *AlertSeverity) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = AlertSeverity(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z AlertSeverity) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *Audit) DecodeMsg(dc *msgp.Reader) (err error) {
 	var zb0003 uint32
@@ -2071,6 +2123,8 @@ func (z *BucketUsageInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
 		field, err = dc.ReadMapKeyPtr()
@@ -2195,6 +2249,35 @@ func (z *BucketUsageInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 				z.ObjectVersionsHistogram[za0003] = za0004
 			}
+		case "objectsCountByStorageClass":
+			var zb0004 uint32
+			zb0004, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+				return
+			}
+			if z.ObjectsCountByStorageClass == nil {
+				z.ObjectsCountByStorageClass = make(map[string]uint64, zb0004)
+			} else if len(z.ObjectsCountByStorageClass) > 0 {
+				clear(z.ObjectsCountByStorageClass)
+			}
+			for zb0004 > 0 {
+				zb0004--
+				var za0005 string
+				za0005, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+					return
+				}
+				var za0006 uint64
+				za0006, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass", za0005)
+					return
+				}
+				z.ObjectsCountByStorageClass[za0005] = za0006
+			}
+			zb0001Mask |= 0x1
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -2203,256 +2286,325 @@ func (z *BucketUsageInfo) DecodeMsg(dc *msgp.Reader) (err error) {
 			}
 		}
 	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.ObjectsCountByStorageClass = nil
+	}
+
 	return
 }
 
 // EncodeMsg implements msgp.Encodable
 func (z *BucketUsageInfo) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 12
-	// write "size"
-	err = en.Append(0x8c, 0xa4, 0x73, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.Size)
-	if err != nil {
-		err = msgp.WrapError(err, "Size")
-		return
-	}
-	// write "objectsPendingReplicationTotalSize"
-	err = en.Append(0xd9, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicationPendingSize)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicationPendingSize")
-		return
-	}
-	// write "objectsFailedReplicationTotalSize"
-	err = en.Append(0xd9, 0x21, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicationFailedSize)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicationFailedSize")
-		return
-	}
-	// write "objectsReplicatedTotalSize"
-	err = en.Append(0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicatedSize)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicatedSize")
-		return
-	}
-	// write "objectReplicaTotalSize"
-	err = en.Append(0xb6, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicaSize)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicaSize")
-		return
-	}
-	// write "objectsPendingReplicationCount"
-	err = en.Append(0xbe, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicationPendingCount)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicationPendingCount")
-		return
-	}
-	// write "objectsFailedReplicationCount"
-	err = en.Append(0xbd, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ReplicationFailedCount)
-	if err != nil {
-		err = msgp.WrapError(err, "ReplicationFailedCount")
-		return
-	}
-	// write "versionsCount"
-	err = en.Append(0xad, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.VersionsCount)
-	if err != nil {
-		err = msgp.WrapError(err, "VersionsCount")
-		return
-	}
-	// write "objectsCount"
-	err = en.Append(0xac, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.ObjectsCount)
-	if err != nil {
-		err = msgp.WrapError(err, "ObjectsCount")
-		return
-	}
-	// write "deleteMarkersCount"
-	err = en.Append(0xb2, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.DeleteMarkersCount)
-	if err != nil {
-		err = msgp.WrapError(err, "DeleteMarkersCount")
-		return
-	}
-	// write "objectsSizesHistogram"
-	err = en.Append(0xb5, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
-	if err != nil {
-		return
+	// check for omitted fields
+	zb0001Len := uint32(13)
+	var zb0001Mask uint16 /* 13 bits */
+	_ = zb0001Mask
+	if z.ObjectsCountByStorageClass == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1000
 	}
-	err = en.WriteMapHeader(uint32(len(z.ObjectSizesHistogram)))
+	// variable map header, size zb0001Len
+	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
-		err = msgp.WrapError(err, "ObjectSizesHistogram")
 		return
 	}
-	for za0001, za0002 := range z.ObjectSizesHistogram {
-		err = en.WriteString(za0001)
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// write "size"
+		err = en.Append(0xa4, 0x73, 0x69, 0x7a, 0x65)
 		if err != nil {
-			err = msgp.WrapError(err, "ObjectSizesHistogram")
 			return
 		}
-		err = en.WriteUint64(za0002)
+		err = en.WriteUint64(z.Size)
 		if err != nil {
-			err = msgp.WrapError(err, "ObjectSizesHistogram", za0001)
+			err = msgp.WrapError(err, "Size")
 			return
 		}
-	}
-	// write "objectsVersionsHistogram"
-	err = en.Append(0xb8, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
-	if err != nil {
-		return
-	}
-	err = en.WriteMapHeader(uint32(len(z.ObjectVersionsHistogram)))
-	if err != nil {
-		err = msgp.WrapError(err, "ObjectVersionsHistogram")
-		return
-	}
-	for za0003, za0004 := range z.ObjectVersionsHistogram {
-		err = en.WriteString(za0003)
+		// write "objectsPendingReplicationTotalSize"
+		err = en.Append(0xd9, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
 		if err != nil {
-			err = msgp.WrapError(err, "ObjectVersionsHistogram")
 			return
 		}
-		err = en.WriteUint64(za0004)
+		err = en.WriteUint64(z.ReplicationPendingSize)
 		if err != nil {
-			err = msgp.WrapError(err, "ObjectVersionsHistogram", za0003)
+			err = msgp.WrapError(err, "ReplicationPendingSize")
 			return
 		}
-	}
-	return
-}
-
-// MarshalMsg implements msgp.Marshaler
-func (z *BucketUsageInfo) MarshalMsg(b []byte) (o []byte, err error) {
-	o = msgp.Require(b, z.Msgsize())
-	// map header, size 12
-	// string "size"
-	o = append(o, 0x8c, 0xa4, 0x73, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.Size)
-	// string "objectsPendingReplicationTotalSize"
-	o = append(o, 0xd9, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.ReplicationPendingSize)
-	// string "objectsFailedReplicationTotalSize"
-	o = append(o, 0xd9, 0x21, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.ReplicationFailedSize)
-	// string "objectsReplicatedTotalSize"
-	o = append(o, 0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.ReplicatedSize)
-	// string "objectReplicaTotalSize"
-	o = append(o, 0xb6, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.ReplicaSize)
-	// string "objectsPendingReplicationCount"
-	o = append(o, 0xbe, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendUint64(o, z.ReplicationPendingCount)
-	// string "objectsFailedReplicationCount"
-	o = append(o, 0xbd, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendUint64(o, z.ReplicationFailedCount)
-	// string "versionsCount"
-	o = append(o, 0xad, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendUint64(o, z.VersionsCount)
-	// string "objectsCount"
-	o = append(o, 0xac, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendUint64(o, z.ObjectsCount)
-	// string "deleteMarkersCount"
-	o = append(o, 0xb2, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
-	o = msgp.AppendUint64(o, z.DeleteMarkersCount)
-	// string "objectsSizesHistogram"
-	o = append(o, 0xb5, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
-	o = msgp.AppendMapHeader(o, uint32(len(z.ObjectSizesHistogram)))
-	for za0001, za0002 := range z.ObjectSizesHistogram {
-		o = msgp.AppendString(o, za0001)
-		o = msgp.AppendUint64(o, za0002)
-	}
-	// string "objectsVersionsHistogram"
-	o = append(o, 0xb8, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
-	o = msgp.AppendMapHeader(o, uint32(len(z.ObjectVersionsHistogram)))
-	for za0003, za0004 := range z.ObjectVersionsHistogram {
-		o = msgp.AppendString(o, za0003)
-		o = msgp.AppendUint64(o, za0004)
-	}
-	return
-}
-
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *BucketUsageInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	for zb0001 > 0 {
-		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
+		// write "objectsFailedReplicationTotalSize"
+		err = en.Append(0xd9, 0x21, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
 		if err != nil {
-			err = msgp.WrapError(err)
 			return
 		}
-		switch msgp.UnsafeString(field) {
-		case "size":
-			z.Size, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Size")
-				return
-			}
-		case "objectsPendingReplicationTotalSize":
-			z.ReplicationPendingSize, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "ReplicationPendingSize")
-				return
-			}
-		case "objectsFailedReplicationTotalSize":
-			z.ReplicationFailedSize, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "ReplicationFailedSize")
-				return
-			}
-		case "objectsReplicatedTotalSize":
-			z.ReplicatedSize, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "ReplicatedSize")
-				return
-			}
-		case "objectReplicaTotalSize":
-			z.ReplicaSize, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "ReplicaSize")
+		err = en.WriteUint64(z.ReplicationFailedSize)
+		if err != nil {
+			err = msgp.WrapError(err, "ReplicationFailedSize")
+			return
+		}
+		// write "objectsReplicatedTotalSize"
+		err = en.Append(0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.ReplicatedSize)
+		if err != nil {
+			err = msgp.WrapError(err, "ReplicatedSize")
+			return
+		}
+		// write "objectReplicaTotalSize"
+		err = en.Append(0xb6, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.ReplicaSize)
+		if err != nil {
+			err = msgp.WrapError(err, "ReplicaSize")
+			return
+		}
+		// write "objectsPendingReplicationCount"
+		err = en.Append(0xbe, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.ReplicationPendingCount)
+		if err != nil {
+			err = msgp.WrapError(err, "ReplicationPendingCount")
+			return
+		}
+		// write "objectsFailedReplicationCount"
+		err = en.Append(0xbd, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.ReplicationFailedCount)
+		if err != nil {
+			err = msgp.WrapError(err, "ReplicationFailedCount")
+			return
+		}
+		// write "versionsCount"
+		err = en.Append(0xad, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.VersionsCount)
+		if err != nil {
+			err = msgp.WrapError(err, "VersionsCount")
+			return
+		}
+		// write "objectsCount"
+		err = en.Append(0xac, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.ObjectsCount)
+		if err != nil {
+			err = msgp.WrapError(err, "ObjectsCount")
+			return
+		}
+		// write "deleteMarkersCount"
+		err = en.Append(0xb2, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteUint64(z.DeleteMarkersCount)
+		if err != nil {
+			err = msgp.WrapError(err, "DeleteMarkersCount")
+			return
+		}
+		// write "objectsSizesHistogram"
+		err = en.Append(0xb5, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
+		if err != nil {
+			return
+		}
+		err = en.WriteMapHeader(uint32(len(z.ObjectSizesHistogram)))
+		if err != nil {
+			err = msgp.WrapError(err, "ObjectSizesHistogram")
+			return
+		}
+		for za0001, za0002 := range z.ObjectSizesHistogram {
+			err = en.WriteString(za0001)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectSizesHistogram")
+				return
+			}
+			err = en.WriteUint64(za0002)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectSizesHistogram", za0001)
+				return
+			}
+		}
+		// write "objectsVersionsHistogram"
+		err = en.Append(0xb8, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
+		if err != nil {
+			return
+		}
+		err = en.WriteMapHeader(uint32(len(z.ObjectVersionsHistogram)))
+		if err != nil {
+			err = msgp.WrapError(err, "ObjectVersionsHistogram")
+			return
+		}
+		for za0003, za0004 := range z.ObjectVersionsHistogram {
+			err = en.WriteString(za0003)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectVersionsHistogram")
+				return
+			}
+			err = en.WriteUint64(za0004)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectVersionsHistogram", za0003)
+				return
+			}
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// write "objectsCountByStorageClass"
+			err = en.Append(0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.ObjectsCountByStorageClass)))
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+				return
+			}
+			for za0005, za0006 := range z.ObjectsCountByStorageClass {
+				err = en.WriteString(za0005)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+					return
+				}
+				err = en.WriteUint64(za0006)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass", za0005)
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *BucketUsageInfo) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// check for omitted fields
+	zb0001Len := uint32(13)
+	var zb0001Mask uint16 /* 13 bits */
+	_ = zb0001Mask
+	if z.ObjectsCountByStorageClass == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+
+	// skip if no fields are to be emitted
+	if zb0001Len != 0 {
+		// string "size"
+		o = append(o, 0xa4, 0x73, 0x69, 0x7a, 0x65)
+		o = msgp.AppendUint64(o, z.Size)
+		// string "objectsPendingReplicationTotalSize"
+		o = append(o, 0xd9, 0x22, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		o = msgp.AppendUint64(o, z.ReplicationPendingSize)
+		// string "objectsFailedReplicationTotalSize"
+		o = append(o, 0xd9, 0x21, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		o = msgp.AppendUint64(o, z.ReplicationFailedSize)
+		// string "objectsReplicatedTotalSize"
+		o = append(o, 0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		o = msgp.AppendUint64(o, z.ReplicatedSize)
+		// string "objectReplicaTotalSize"
+		o = append(o, 0xb6, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+		o = msgp.AppendUint64(o, z.ReplicaSize)
+		// string "objectsPendingReplicationCount"
+		o = append(o, 0xbe, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint64(o, z.ReplicationPendingCount)
+		// string "objectsFailedReplicationCount"
+		o = append(o, 0xbd, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint64(o, z.ReplicationFailedCount)
+		// string "versionsCount"
+		o = append(o, 0xad, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint64(o, z.VersionsCount)
+		// string "objectsCount"
+		o = append(o, 0xac, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint64(o, z.ObjectsCount)
+		// string "deleteMarkersCount"
+		o = append(o, 0xb2, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint64(o, z.DeleteMarkersCount)
+		// string "objectsSizesHistogram"
+		o = append(o, 0xb5, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
+		o = msgp.AppendMapHeader(o, uint32(len(z.ObjectSizesHistogram)))
+		for za0001, za0002 := range z.ObjectSizesHistogram {
+			o = msgp.AppendString(o, za0001)
+			o = msgp.AppendUint64(o, za0002)
+		}
+		// string "objectsVersionsHistogram"
+		o = append(o, 0xb8, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d)
+		o = msgp.AppendMapHeader(o, uint32(len(z.ObjectVersionsHistogram)))
+		for za0003, za0004 := range z.ObjectVersionsHistogram {
+			o = msgp.AppendString(o, za0003)
+			o = msgp.AppendUint64(o, za0004)
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+			// string "objectsCountByStorageClass"
+			o = append(o, 0xba, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73)
+			o = msgp.AppendMapHeader(o, uint32(len(z.ObjectsCountByStorageClass)))
+			for za0005, za0006 := range z.ObjectsCountByStorageClass {
+				o = msgp.AppendString(o, za0005)
+				o = msgp.AppendUint64(o, za0006)
+			}
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *BucketUsageInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	var zb0001Mask uint8 /* 1 bits */
+	_ = zb0001Mask
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "size":
+			z.Size, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Size")
+				return
+			}
+		case "objectsPendingReplicationTotalSize":
+			z.ReplicationPendingSize, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReplicationPendingSize")
+				return
+			}
+		case "objectsFailedReplicationTotalSize":
+			z.ReplicationFailedSize, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReplicationFailedSize")
+				return
+			}
+		case "objectsReplicatedTotalSize":
+			z.ReplicatedSize, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReplicatedSize")
+				return
+			}
+		case "objectReplicaTotalSize":
+			z.ReplicaSize, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReplicaSize")
 				return
 			}
 		case "objectsPendingReplicationCount":
@@ -2541,6 +2693,35 @@ func (z *BucketUsageInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.ObjectVersionsHistogram[za0003] = za0004
 			}
+		case "objectsCountByStorageClass":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+				return
+			}
+			if z.ObjectsCountByStorageClass == nil {
+				z.ObjectsCountByStorageClass = make(map[string]uint64, zb0004)
+			} else if len(z.ObjectsCountByStorageClass) > 0 {
+				clear(z.ObjectsCountByStorageClass)
+			}
+			for zb0004 > 0 {
+				var za0006 uint64
+				zb0004--
+				var za0005 string
+				za0005, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass")
+					return
+				}
+				za0006, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ObjectsCountByStorageClass", za0005)
+					return
+				}
+				z.ObjectsCountByStorageClass[za0005] = za0006
+			}
+			zb0001Mask |= 0x1
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -2549,6 +2730,11 @@ func (z *BucketUsageInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			}
 		}
 	}
+	// Clear omitted fields.
+	if (zb0001Mask & 0x1) == 0 {
+		z.ObjectsCountByStorageClass = nil
+	}
+
 	o = bts
 	return
 }
@@ -2569,183 +2755,13 @@ func (z *BucketUsageInfo) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0003) + msgp.Uint64Size
 		}
 	}
-	return
-}
-
-// DecodeMsg implements msgp.Decodable
-func (z *Buckets) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, err = dc.ReadUint64()
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, err = dc.ReadString()
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z Buckets) EncodeMsg(en *msgp.Writer) (err error) {
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
-	if err != nil {
-		return
-	}
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// write "count"
-		err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		if err != nil {
-			return
-		}
-		err = en.WriteUint64(z.Count)
-		if err != nil {
-			err = msgp.WrapError(err, "Count")
-			return
-		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// write "error"
-			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			if err != nil {
-				return
-			}
-			err = en.WriteString(z.Error)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-		}
-	}
-	return
-}
-
-// MarshalMsg implements msgp.Marshaler
-func (z Buckets) MarshalMsg(b []byte) (o []byte, err error) {
-	o = msgp.Require(b, z.Msgsize())
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// string "count"
-		o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		o = msgp.AppendUint64(o, z.Count)
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// string "error"
-			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			o = msgp.AppendString(o, z.Error)
-		}
-	}
-	return
-}
-
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *Buckets) UnmarshalMsg(bts []byte) (o []byte, err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, bts, err = msgp.ReadStringBytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			bts, err = msgp.Skip(bts)
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
+	s += 27 + msgp.MapHeaderSize
+	if z.ObjectsCountByStorageClass != nil {
+		for za0005, za0006 := range z.ObjectsCountByStorageClass {
+			_ = za0006
+			s += msgp.StringPrefixSize + len(za0005) + msgp.Uint64Size
 		}
 	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	o = bts
-	return
-}
-
-// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z Buckets) Msgsize() (s int) {
-	s = 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Error)
 	return
 }
 
@@ -4456,240 +4472,54 @@ func (z *DataUsageInfo) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					if err != nil {
 						err = msgp.WrapError(err, "TierStats", za0003)
 						return
-					}
-					switch msgp.UnsafeString(field) {
-					case "totalSize":
-						za0004.TotalSize, bts, err = msgp.ReadUint64Bytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "TierStats", za0003, "TotalSize")
-							return
-						}
-					case "numVersions":
-						za0004.NumVersions, bts, err = msgp.ReadIntBytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "TierStats", za0003, "NumVersions")
-							return
-						}
-					case "numObjects":
-						za0004.NumObjects, bts, err = msgp.ReadIntBytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "TierStats", za0003, "NumObjects")
-							return
-						}
-					default:
-						bts, err = msgp.Skip(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "TierStats", za0003)
-							return
-						}
-					}
-				}
-				z.TierStats[za0003] = za0004
-			}
-		case "capacity":
-			z.TotalCapacity, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "TotalCapacity")
-				return
-			}
-		case "freeCapacity":
-			z.TotalFreeCapacity, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "TotalFreeCapacity")
-				return
-			}
-		case "usedCapacity":
-			z.TotalUsedCapacity, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "TotalUsedCapacity")
-				return
-			}
-		default:
-			bts, err = msgp.Skip(bts)
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	o = bts
-	return
-}
-
-// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z *DataUsageInfo) Msgsize() (s int) {
-	s = 1 + 11 + msgp.TimeSize + 13 + msgp.Uint64Size + 17 + msgp.Uint64Size + 36 + msgp.Uint64Size + 35 + msgp.Uint64Size + 27 + msgp.Uint64Size + 24 + msgp.Uint64Size + 31 + msgp.Uint64Size + 30 + msgp.Uint64Size + 13 + msgp.Uint64Size + 17 + msgp.MapHeaderSize
-	if z.BucketsUsage != nil {
-		for za0001, za0002 := range z.BucketsUsage {
-			_ = za0002
-			s += msgp.StringPrefixSize + len(za0001) + za0002.Msgsize()
-		}
-	}
-	s += 10 + msgp.MapHeaderSize
-	if z.TierStats != nil {
-		for za0003, za0004 := range z.TierStats {
-			_ = za0004
-			s += msgp.StringPrefixSize + len(za0003) + 1 + 10 + msgp.Uint64Size + 12 + msgp.IntSize + 11 + msgp.IntSize
-		}
-	}
-	s += 9 + msgp.Uint64Size + 13 + msgp.Uint64Size + 13 + msgp.Uint64Size
-	return
-}
-
-// DecodeMsg implements msgp.Decodable
-func (z *DeleteMarkers) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, err = dc.ReadUint64()
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, err = dc.ReadString()
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z DeleteMarkers) EncodeMsg(en *msgp.Writer) (err error) {
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
-	if err != nil {
-		return
-	}
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// write "count"
-		err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		if err != nil {
-			return
-		}
-		err = en.WriteUint64(z.Count)
-		if err != nil {
-			err = msgp.WrapError(err, "Count")
-			return
-		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// write "error"
-			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			if err != nil {
-				return
+					}
+					switch msgp.UnsafeString(field) {
+					case "totalSize":
+						za0004.TotalSize, bts, err = msgp.ReadUint64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TierStats", za0003, "TotalSize")
+							return
+						}
+					case "numVersions":
+						za0004.NumVersions, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TierStats", za0003, "NumVersions")
+							return
+						}
+					case "numObjects":
+						za0004.NumObjects, bts, err = msgp.ReadIntBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TierStats", za0003, "NumObjects")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "TierStats", za0003)
+							return
+						}
+					}
+				}
+				z.TierStats[za0003] = za0004
 			}
-			err = en.WriteString(z.Error)
+		case "capacity":
+			z.TotalCapacity, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Error")
+				err = msgp.WrapError(err, "TotalCapacity")
 				return
 			}
-		}
-	}
-	return
-}
-
-// MarshalMsg implements msgp.Marshaler
-func (z DeleteMarkers) MarshalMsg(b []byte) (o []byte, err error) {
-	o = msgp.Require(b, z.Msgsize())
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// string "count"
-		o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		o = msgp.AppendUint64(o, z.Count)
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// string "error"
-			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			o = msgp.AppendString(o, z.Error)
-		}
-	}
-	return
-}
-
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *DeleteMarkers) UnmarshalMsg(bts []byte) (o []byte, err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, bts, err = msgp.ReadUint64Bytes(bts)
+		case "freeCapacity":
+			z.TotalFreeCapacity, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Count")
+				err = msgp.WrapError(err, "TotalFreeCapacity")
 				return
 			}
-		case "error":
-			z.Error, bts, err = msgp.ReadStringBytes(bts)
+		case "usedCapacity":
+			z.TotalUsedCapacity, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Error")
+				err = msgp.WrapError(err, "TotalUsedCapacity")
 				return
 			}
-			zb0001Mask |= 0x1
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -4698,18 +4528,27 @@ func (z *DeleteMarkers) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			}
 		}
 	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
 	o = bts
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z DeleteMarkers) Msgsize() (s int) {
-	s = 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Error)
+func (z *DataUsageInfo) Msgsize() (s int) {
+	s = 1 + 11 + msgp.TimeSize + 13 + msgp.Uint64Size + 17 + msgp.Uint64Size + 36 + msgp.Uint64Size + 35 + msgp.Uint64Size + 27 + msgp.Uint64Size + 24 + msgp.Uint64Size + 31 + msgp.Uint64Size + 30 + msgp.Uint64Size + 13 + msgp.Uint64Size + 17 + msgp.MapHeaderSize
+	if z.BucketsUsage != nil {
+		for za0001, za0002 := range z.BucketsUsage {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + za0002.Msgsize()
+		}
+	}
+	s += 10 + msgp.MapHeaderSize
+	if z.TierStats != nil {
+		for za0003, za0004 := range z.TierStats {
+			_ = za0004
+			s += msgp.StringPrefixSize + len(za0003) + 1 + 10 + msgp.Uint64Size + 12 + msgp.IntSize + 11 + msgp.IntSize
+		}
+	}
+	s += 9 + msgp.Uint64Size + 13 + msgp.Uint64Size + 13 + msgp.Uint64Size
 	return
 }
 
@@ -4723,7 +4562,7 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint32 /* 23 bits */
+	var zb0001Mask uint32 /* 24 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -4876,63 +4715,11 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 				if z.Metrics == nil {
 					z.Metrics = new(DiskStatus)
 				}
-				var zb0002 uint32
-				zb0002, err = dc.ReadMapHeader()
+				err = z.Metrics.DecodeMsg(dc)
 				if err != nil {
 					err = msgp.WrapError(err, "Metrics")
 					return
 				}
-				var zb0002Mask uint8 /* 3 bits */
-				_ = zb0002Mask
-				for zb0002 > 0 {
-					zb0002--
-					field, err = dc.ReadMapKeyPtr()
-					if err != nil {
-						err = msgp.WrapError(err, "Metrics")
-						return
-					}
-					switch msgp.UnsafeString(field) {
-					case "totalWaiting":
-						z.Metrics.TotalWaiting, err = dc.ReadUint32()
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalWaiting")
-							return
-						}
-						zb0002Mask |= 0x1
-					case "totalErrorsAvailability":
-						z.Metrics.TotalErrorsAvailability, err = dc.ReadUint64()
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsAvailability")
-							return
-						}
-						zb0002Mask |= 0x2
-					case "totalErrorsTimeout":
-						z.Metrics.TotalErrorsTimeout, err = dc.ReadUint64()
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsTimeout")
-							return
-						}
-						zb0002Mask |= 0x4
-					default:
-						err = dc.Skip()
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics")
-							return
-						}
-					}
-				}
-				// Clear omitted fields.
-				if zb0002Mask != 0x7 {
-					if (zb0002Mask & 0x1) == 0 {
-						z.Metrics.TotalWaiting = 0
-					}
-					if (zb0002Mask & 0x2) == 0 {
-						z.Metrics.TotalErrorsAvailability = 0
-					}
-					if (zb0002Mask & 0x4) == 0 {
-						z.Metrics.TotalErrorsTimeout = 0
-					}
-				}
 			}
 			zb0001Mask |= 0x20000
 		case "heal_info":
@@ -5012,6 +4799,13 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 			}
 			zb0001Mask |= 0x400000
+		case "metadata":
+			z.Metadata, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Metadata")
+				return
+			}
+			zb0001Mask |= 0x800000
 		case "pool_index":
 			z.PoolIndex, err = dc.ReadInt()
 			if err != nil {
@@ -5039,7 +4833,7 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0x7fffff {
+	if zb0001Mask != 0xffffff {
 		if (zb0001Mask & 0x1) == 0 {
 			z.Endpoint = ""
 		}
@@ -5109,6 +4903,9 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 		if (zb0001Mask & 0x400000) == 0 {
 			z.Cache = nil
 		}
+		if (zb0001Mask & 0x800000) == 0 {
+			z.Metadata = false
+		}
 	}
 	return
 }
@@ -5116,8 +4913,8 @@ func (z *Disk) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *Disk) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(29)
-	var zb0001Mask uint32 /* 29 bits */
+	zb0001Len := uint32(30)
+	var zb0001Mask uint32 /* 30 bits */
 	_ = zb0001Mask
 	if z.Endpoint == "" {
 		zb0001Len--
@@ -5211,6 +5008,10 @@ func (z *Disk) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x2000000
 	}
+	if z.Metadata == false {
+		zb0001Len--
+		zb0001Mask |= 0x4000000
+	}
 	// variable map header, size zb0001Len
 	err = en.WriteMapHeader(zb0001Len)
 	if err != nil {
@@ -5455,67 +5256,11 @@ func (z *Disk) EncodeMsg(en *msgp.Writer) (err error) {
 					return
 				}
 			} else {
-				// check for omitted fields
-				zb0002Len := uint32(3)
-				var zb0002Mask uint8 /* 3 bits */
-				_ = zb0002Mask
-				if z.Metrics.TotalWaiting == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x1
-				}
-				if z.Metrics.TotalErrorsAvailability == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x2
-				}
-				if z.Metrics.TotalErrorsTimeout == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x4
-				}
-				// variable map header, size zb0002Len
-				err = en.Append(0x80 | uint8(zb0002Len))
+				err = z.Metrics.EncodeMsg(en)
 				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
 					return
 				}
-
-				// skip if no fields are to be emitted
-				if zb0002Len != 0 {
-					if (zb0002Mask & 0x1) == 0 { // if not omitted
-						// write "totalWaiting"
-						err = en.Append(0xac, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67)
-						if err != nil {
-							return
-						}
-						err = en.WriteUint32(z.Metrics.TotalWaiting)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalWaiting")
-							return
-						}
-					}
-					if (zb0002Mask & 0x2) == 0 { // if not omitted
-						// write "totalErrorsAvailability"
-						err = en.Append(0xb7, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79)
-						if err != nil {
-							return
-						}
-						err = en.WriteUint64(z.Metrics.TotalErrorsAvailability)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsAvailability")
-							return
-						}
-					}
-					if (zb0002Mask & 0x4) == 0 { // if not omitted
-						// write "totalErrorsTimeout"
-						err = en.Append(0xb2, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74)
-						if err != nil {
-							return
-						}
-						err = en.WriteUint64(z.Metrics.TotalErrorsTimeout)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsTimeout")
-							return
-						}
-					}
-				}
 			}
 		}
 		if (zb0001Mask & 0x100000) == 0 { // if not omitted
@@ -5609,6 +5354,18 @@ func (z *Disk) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+			// write "metadata"
+			err = en.Append(0xa8, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61)
+			if err != nil {
+				return
+			}
+			err = en.WriteBool(z.Metadata)
+			if err != nil {
+				err = msgp.WrapError(err, "Metadata")
+				return
+			}
+		}
 		// write "pool_index"
 		err = en.Append(0xaa, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78)
 		if err != nil {
@@ -5647,8 +5404,8 @@ func (z *Disk) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *Disk) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(29)
-	var zb0001Mask uint32 /* 29 bits */
+	zb0001Len := uint32(30)
+	var zb0001Mask uint32 /* 30 bits */
 	_ = zb0001Mask
 	if z.Endpoint == "" {
 		zb0001Len--
@@ -5742,6 +5499,10 @@ func (z *Disk) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x2000000
 	}
+	if z.Metadata == false {
+		zb0001Len--
+		zb0001Mask |= 0x4000000
+	}
 	// variable map header, size zb0001Len
 	o = msgp.AppendMapHeader(o, zb0001Len)
 
@@ -5844,42 +5605,10 @@ func (z *Disk) MarshalMsg(b []byte) (o []byte, err error) {
 			if z.Metrics == nil {
 				o = msgp.AppendNil(o)
 			} else {
-				// check for omitted fields
-				zb0002Len := uint32(3)
-				var zb0002Mask uint8 /* 3 bits */
-				_ = zb0002Mask
-				if z.Metrics.TotalWaiting == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x1
-				}
-				if z.Metrics.TotalErrorsAvailability == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x2
-				}
-				if z.Metrics.TotalErrorsTimeout == 0 {
-					zb0002Len--
-					zb0002Mask |= 0x4
-				}
-				// variable map header, size zb0002Len
-				o = append(o, 0x80|uint8(zb0002Len))
-
-				// skip if no fields are to be emitted
-				if zb0002Len != 0 {
-					if (zb0002Mask & 0x1) == 0 { // if not omitted
-						// string "totalWaiting"
-						o = append(o, 0xac, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67)
-						o = msgp.AppendUint32(o, z.Metrics.TotalWaiting)
-					}
-					if (zb0002Mask & 0x2) == 0 { // if not omitted
-						// string "totalErrorsAvailability"
-						o = append(o, 0xb7, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79)
-						o = msgp.AppendUint64(o, z.Metrics.TotalErrorsAvailability)
-					}
-					if (zb0002Mask & 0x4) == 0 { // if not omitted
-						// string "totalErrorsTimeout"
-						o = append(o, 0xb2, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74)
-						o = msgp.AppendUint64(o, z.Metrics.TotalErrorsTimeout)
-					}
+				o, err = z.Metrics.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "Metrics")
+					return
 				}
 			}
 		}
@@ -5935,6 +5664,11 @@ func (z *Disk) MarshalMsg(b []byte) (o []byte, err error) {
 				}
 			}
 		}
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+			// string "metadata"
+			o = append(o, 0xa8, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61)
+			o = msgp.AppendBool(o, z.Metadata)
+		}
 		// string "pool_index"
 		o = append(o, 0xaa, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78)
 		o = msgp.AppendInt(o, z.PoolIndex)
@@ -5958,7 +5692,7 @@ func (z *Disk) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint32 /* 23 bits */
+	var zb0001Mask uint32 /* 24 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -6110,63 +5844,11 @@ func (z *Disk) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				if z.Metrics == nil {
 					z.Metrics = new(DiskStatus)
 				}
-				var zb0002 uint32
-				zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+				bts, err = z.Metrics.UnmarshalMsg(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Metrics")
 					return
 				}
-				var zb0002Mask uint8 /* 3 bits */
-				_ = zb0002Mask
-				for zb0002 > 0 {
-					zb0002--
-					field, bts, err = msgp.ReadMapKeyZC(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Metrics")
-						return
-					}
-					switch msgp.UnsafeString(field) {
-					case "totalWaiting":
-						z.Metrics.TotalWaiting, bts, err = msgp.ReadUint32Bytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalWaiting")
-							return
-						}
-						zb0002Mask |= 0x1
-					case "totalErrorsAvailability":
-						z.Metrics.TotalErrorsAvailability, bts, err = msgp.ReadUint64Bytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsAvailability")
-							return
-						}
-						zb0002Mask |= 0x2
-					case "totalErrorsTimeout":
-						z.Metrics.TotalErrorsTimeout, bts, err = msgp.ReadUint64Bytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics", "TotalErrorsTimeout")
-							return
-						}
-						zb0002Mask |= 0x4
-					default:
-						bts, err = msgp.Skip(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "Metrics")
-							return
-						}
-					}
-				}
-				// Clear omitted fields.
-				if zb0002Mask != 0x7 {
-					if (zb0002Mask & 0x1) == 0 {
-						z.Metrics.TotalWaiting = 0
-					}
-					if (zb0002Mask & 0x2) == 0 {
-						z.Metrics.TotalErrorsAvailability = 0
-					}
-					if (zb0002Mask & 0x4) == 0 {
-						z.Metrics.TotalErrorsTimeout = 0
-					}
-				}
 			}
 			zb0001Mask |= 0x20000
 		case "heal_info":
@@ -6243,6 +5925,13 @@ func (z *Disk) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 			zb0001Mask |= 0x400000
+		case "metadata":
+			z.Metadata, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Metadata")
+				return
+			}
+			zb0001Mask |= 0x800000
 		case "pool_index":
 			z.PoolIndex, bts, err = msgp.ReadIntBytes(bts)
 			if err != nil {
@@ -6270,7 +5959,7 @@ func (z *Disk) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0x7fffff {
+	if zb0001Mask != 0xffffff {
 		if (zb0001Mask & 0x1) == 0 {
 			z.Endpoint = ""
 		}
@@ -6340,6 +6029,9 @@ func (z *Disk) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		if (zb0001Mask & 0x400000) == 0 {
 			z.Cache = nil
 		}
+		if (zb0001Mask & 0x800000) == 0 {
+			z.Metadata = false
+		}
 	}
 	o = bts
 	return
@@ -6351,7 +6043,7 @@ func (z *Disk) Msgsize() (s int) {
 	if z.Metrics == nil {
 		s += msgp.NilSize
 	} else {
-		s += 1 + 13 + msgp.Uint32Size + 24 + msgp.Uint64Size + 19 + msgp.Uint64Size
+		s += z.Metrics.Msgsize()
 	}
 	s += 10
 	if z.HealInfo == nil {
@@ -6371,7 +6063,7 @@ func (z *Disk) Msgsize() (s int) {
 	} else {
 		s += z.Cache.Msgsize()
 	}
-	s += 11 + msgp.IntSize + 10 + msgp.IntSize + 11 + msgp.IntSize
+	s += 9 + msgp.BoolSize + 11 + msgp.IntSize + 10 + msgp.IntSize + 11 + msgp.IntSize
 	return
 }
 
@@ -6385,7 +6077,7 @@ func (z *DiskStatus) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 3 bits */
+	var zb0001Mask uint8 /* 6 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -6395,27 +6087,92 @@ func (z *DiskStatus) DecodeMsg(dc *msgp.Reader) (err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
+		case "totalTokens":
+			z.TotalTokens, err = dc.ReadUint32()
+			if err != nil {
+				err = msgp.WrapError(err, "TotalTokens")
+				return
+			}
+			zb0001Mask |= 0x1
 		case "totalWaiting":
 			z.TotalWaiting, err = dc.ReadUint32()
 			if err != nil {
 				err = msgp.WrapError(err, "TotalWaiting")
 				return
 			}
-			zb0001Mask |= 0x1
+			zb0001Mask |= 0x2
 		case "totalErrorsAvailability":
 			z.TotalErrorsAvailability, err = dc.ReadUint64()
 			if err != nil {
 				err = msgp.WrapError(err, "TotalErrorsAvailability")
 				return
 			}
-			zb0001Mask |= 0x2
+			zb0001Mask |= 0x4
 		case "totalErrorsTimeout":
 			z.TotalErrorsTimeout, err = dc.ReadUint64()
 			if err != nil {
-				err = msgp.WrapError(err, "TotalErrorsTimeout")
+				err = msgp.WrapError(err, "TotalErrorsTimeout")
+				return
+			}
+			zb0001Mask |= 0x8
+		case "apiCalls":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "APICalls")
+				return
+			}
+			if z.APICalls == nil {
+				z.APICalls = make(map[string]uint64, zb0002)
+			} else if len(z.APICalls) > 0 {
+				clear(z.APICalls)
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls")
+					return
+				}
+				var za0002 uint64
+				za0002, err = dc.ReadUint64()
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls", za0001)
+					return
+				}
+				z.APICalls[za0001] = za0002
+			}
+			zb0001Mask |= 0x10
+		case "lastMinute":
+			var zb0003 uint32
+			zb0003, err = dc.ReadMapHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "LastMinute")
 				return
 			}
-			zb0001Mask |= 0x4
+			if z.LastMinute == nil {
+				z.LastMinute = make(map[string]TimedAction, zb0003)
+			} else if len(z.LastMinute) > 0 {
+				clear(z.LastMinute)
+			}
+			for zb0003 > 0 {
+				zb0003--
+				var za0003 string
+				za0003, err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute")
+					return
+				}
+				var za0004 TimedAction
+				err = za0004.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute", za0003)
+					return
+				}
+				z.LastMinute[za0003] = za0004
+			}
+			zb0001Mask |= 0x20
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -6425,38 +6182,59 @@ func (z *DiskStatus) DecodeMsg(dc *msgp.Reader) (err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0x7 {
+	if zb0001Mask != 0x3f {
 		if (zb0001Mask & 0x1) == 0 {
-			z.TotalWaiting = 0
+			z.TotalTokens = 0
 		}
 		if (zb0001Mask & 0x2) == 0 {
-			z.TotalErrorsAvailability = 0
+			z.TotalWaiting = 0
 		}
 		if (zb0001Mask & 0x4) == 0 {
+			z.TotalErrorsAvailability = 0
+		}
+		if (zb0001Mask & 0x8) == 0 {
 			z.TotalErrorsTimeout = 0
 		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.APICalls = nil
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.LastMinute = nil
+		}
 	}
 	return
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
+func (z *DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(3)
-	var zb0001Mask uint8 /* 3 bits */
+	zb0001Len := uint32(6)
+	var zb0001Mask uint8 /* 6 bits */
 	_ = zb0001Mask
-	if z.TotalWaiting == 0 {
+	if z.TotalTokens == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x1
 	}
-	if z.TotalErrorsAvailability == 0 {
+	if z.TotalWaiting == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x2
 	}
-	if z.TotalErrorsTimeout == 0 {
+	if z.TotalErrorsAvailability == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x4
 	}
+	if z.TotalErrorsTimeout == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.APICalls == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.LastMinute == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
 	// variable map header, size zb0001Len
 	err = en.Append(0x80 | uint8(zb0001Len))
 	if err != nil {
@@ -6466,6 +6244,18 @@ func (z DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
 	// skip if no fields are to be emitted
 	if zb0001Len != 0 {
 		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// write "totalTokens"
+			err = en.Append(0xab, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteUint32(z.TotalTokens)
+			if err != nil {
+				err = msgp.WrapError(err, "TotalTokens")
+				return
+			}
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
 			// write "totalWaiting"
 			err = en.Append(0xac, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67)
 			if err != nil {
@@ -6477,7 +6267,7 @@ func (z DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
 			// write "totalErrorsAvailability"
 			err = en.Append(0xb7, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79)
 			if err != nil {
@@ -6489,7 +6279,7 @@ func (z DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x4) == 0 { // if not omitted
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
 			// write "totalErrorsTimeout"
 			err = en.Append(0xb2, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74)
 			if err != nil {
@@ -6501,49 +6291,136 @@ func (z DiskStatus) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// write "apiCalls"
+			err = en.Append(0xa8, 0x61, 0x70, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x73)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.APICalls)))
+			if err != nil {
+				err = msgp.WrapError(err, "APICalls")
+				return
+			}
+			for za0001, za0002 := range z.APICalls {
+				err = en.WriteString(za0001)
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls")
+					return
+				}
+				err = en.WriteUint64(za0002)
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls", za0001)
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// write "lastMinute"
+			err = en.Append(0xaa, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
+			if err != nil {
+				return
+			}
+			err = en.WriteMapHeader(uint32(len(z.LastMinute)))
+			if err != nil {
+				err = msgp.WrapError(err, "LastMinute")
+				return
+			}
+			for za0003, za0004 := range z.LastMinute {
+				err = en.WriteString(za0003)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute")
+					return
+				}
+				err = za0004.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute", za0003)
+					return
+				}
+			}
+		}
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z DiskStatus) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *DiskStatus) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(3)
-	var zb0001Mask uint8 /* 3 bits */
+	zb0001Len := uint32(6)
+	var zb0001Mask uint8 /* 6 bits */
 	_ = zb0001Mask
-	if z.TotalWaiting == 0 {
+	if z.TotalTokens == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x1
 	}
-	if z.TotalErrorsAvailability == 0 {
+	if z.TotalWaiting == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x2
 	}
-	if z.TotalErrorsTimeout == 0 {
+	if z.TotalErrorsAvailability == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x4
 	}
+	if z.TotalErrorsTimeout == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.APICalls == nil {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.LastMinute == nil {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 
 	// skip if no fields are to be emitted
 	if zb0001Len != 0 {
 		if (zb0001Mask & 0x1) == 0 { // if not omitted
+			// string "totalTokens"
+			o = append(o, 0xab, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73)
+			o = msgp.AppendUint32(o, z.TotalTokens)
+		}
+		if (zb0001Mask & 0x2) == 0 { // if not omitted
 			// string "totalWaiting"
 			o = append(o, 0xac, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e, 0x67)
 			o = msgp.AppendUint32(o, z.TotalWaiting)
 		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
+		if (zb0001Mask & 0x4) == 0 { // if not omitted
 			// string "totalErrorsAvailability"
 			o = append(o, 0xb7, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79)
 			o = msgp.AppendUint64(o, z.TotalErrorsAvailability)
 		}
-		if (zb0001Mask & 0x4) == 0 { // if not omitted
+		if (zb0001Mask & 0x8) == 0 { // if not omitted
 			// string "totalErrorsTimeout"
 			o = append(o, 0xb2, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74)
 			o = msgp.AppendUint64(o, z.TotalErrorsTimeout)
 		}
+		if (zb0001Mask & 0x10) == 0 { // if not omitted
+			// string "apiCalls"
+			o = append(o, 0xa8, 0x61, 0x70, 0x69, 0x43, 0x61, 0x6c, 0x6c, 0x73)
+			o = msgp.AppendMapHeader(o, uint32(len(z.APICalls)))
+			for za0001, za0002 := range z.APICalls {
+				o = msgp.AppendString(o, za0001)
+				o = msgp.AppendUint64(o, za0002)
+			}
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not omitted
+			// string "lastMinute"
+			o = append(o, 0xaa, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65)
+			o = msgp.AppendMapHeader(o, uint32(len(z.LastMinute)))
+			for za0003, za0004 := range z.LastMinute {
+				o = msgp.AppendString(o, za0003)
+				o, err = za0004.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute", za0003)
+					return
+				}
+			}
+		}
 	}
 	return
 }
@@ -6558,7 +6435,7 @@ func (z *DiskStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 3 bits */
+	var zb0001Mask uint8 /* 6 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -6568,27 +6445,92 @@ func (z *DiskStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
+		case "totalTokens":
+			z.TotalTokens, bts, err = msgp.ReadUint32Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TotalTokens")
+				return
+			}
+			zb0001Mask |= 0x1
 		case "totalWaiting":
 			z.TotalWaiting, bts, err = msgp.ReadUint32Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalWaiting")
 				return
 			}
-			zb0001Mask |= 0x1
+			zb0001Mask |= 0x2
 		case "totalErrorsAvailability":
 			z.TotalErrorsAvailability, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalErrorsAvailability")
 				return
 			}
-			zb0001Mask |= 0x2
+			zb0001Mask |= 0x4
 		case "totalErrorsTimeout":
 			z.TotalErrorsTimeout, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "TotalErrorsTimeout")
 				return
 			}
-			zb0001Mask |= 0x4
+			zb0001Mask |= 0x8
+		case "apiCalls":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "APICalls")
+				return
+			}
+			if z.APICalls == nil {
+				z.APICalls = make(map[string]uint64, zb0002)
+			} else if len(z.APICalls) > 0 {
+				clear(z.APICalls)
+			}
+			for zb0002 > 0 {
+				var za0002 uint64
+				zb0002--
+				var za0001 string
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls")
+					return
+				}
+				za0002, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "APICalls", za0001)
+					return
+				}
+				z.APICalls[za0001] = za0002
+			}
+			zb0001Mask |= 0x10
+		case "lastMinute":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastMinute")
+				return
+			}
+			if z.LastMinute == nil {
+				z.LastMinute = make(map[string]TimedAction, zb0003)
+			} else if len(z.LastMinute) > 0 {
+				clear(z.LastMinute)
+			}
+			for zb0003 > 0 {
+				var za0004 TimedAction
+				zb0003--
+				var za0003 string
+				za0003, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute")
+					return
+				}
+				bts, err = za0004.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "LastMinute", za0003)
+					return
+				}
+				z.LastMinute[za0003] = za0004
+			}
+			zb0001Mask |= 0x20
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -6598,24 +6540,98 @@ func (z *DiskStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0x7 {
+	if zb0001Mask != 0x3f {
 		if (zb0001Mask & 0x1) == 0 {
-			z.TotalWaiting = 0
+			z.TotalTokens = 0
 		}
 		if (zb0001Mask & 0x2) == 0 {
-			z.TotalErrorsAvailability = 0
+			z.TotalWaiting = 0
 		}
 		if (zb0001Mask & 0x4) == 0 {
+			z.TotalErrorsAvailability = 0
+		}
+		if (zb0001Mask & 0x8) == 0 {
 			z.TotalErrorsTimeout = 0
 		}
+		if (zb0001Mask & 0x10) == 0 {
+			z.APICalls = nil
+		}
+		if (zb0001Mask & 0x20) == 0 {
+			z.LastMinute = nil
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *DiskStatus) Msgsize() (s int) {
+	s = 1 + 12 + msgp.Uint32Size + 13 + msgp.Uint32Size + 24 + msgp.Uint64Size + 19 + msgp.Uint64Size + 9 + msgp.MapHeaderSize
+	if z.APICalls != nil {
+		for za0001, za0002 := range z.APICalls {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.Uint64Size
+		}
+	}
+	s += 11 + msgp.MapHeaderSize
+	if z.LastMinute != nil {
+		for za0003, za0004 := range z.LastMinute {
+			_ = za0004
+			s += msgp.StringPrefixSize + len(za0003) + za0004.Msgsize()
+		}
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *DriveMediaType) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DriveMediaType(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z DriveMediaType) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteString(string(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z DriveMediaType) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendString(o, string(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *DriveMediaType) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = DriveMediaType(zb0001)
 	}
 	o = bts
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z DiskStatus) Msgsize() (s int) {
-	s = 1 + 13 + msgp.Uint32Size + 24 + msgp.Uint64Size + 19 + msgp.Uint64Size
+func (z DriveMediaType) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
 	return
 }
 
@@ -8604,224 +8620,39 @@ func (z *InfoMessage) DecodeMsg(dc *msgp.Reader) (err error) {
 			}
 			zb0001Mask |= 0x20
 		case "buckets":
-			var zb0004 uint32
-			zb0004, err = dc.ReadMapHeader()
+			err = z.Buckets.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Buckets")
 				return
 			}
-			var zb0004Mask uint8 /* 1 bits */
-			_ = zb0004Mask
-			for zb0004 > 0 {
-				zb0004--
-				field, err = dc.ReadMapKeyPtr()
-				if err != nil {
-					err = msgp.WrapError(err, "Buckets")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Buckets.Count, err = dc.ReadUint64()
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets", "Count")
-						return
-					}
-				case "error":
-					z.Buckets.Error, err = dc.ReadString()
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets", "Error")
-						return
-					}
-					zb0004Mask |= 0x1
-				default:
-					err = dc.Skip()
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0004Mask & 0x1) == 0 {
-				z.Buckets.Error = ""
-			}
-
 			zb0001Mask |= 0x40
 		case "objects":
-			var zb0005 uint32
-			zb0005, err = dc.ReadMapHeader()
+			err = z.Objects.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Objects")
 				return
 			}
-			var zb0005Mask uint8 /* 1 bits */
-			_ = zb0005Mask
-			for zb0005 > 0 {
-				zb0005--
-				field, err = dc.ReadMapKeyPtr()
-				if err != nil {
-					err = msgp.WrapError(err, "Objects")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Objects.Count, err = dc.ReadUint64()
-					if err != nil {
-						err = msgp.WrapError(err, "Objects", "Count")
-						return
-					}
-				case "error":
-					z.Objects.Error, err = dc.ReadString()
-					if err != nil {
-						err = msgp.WrapError(err, "Objects", "Error")
-						return
-					}
-					zb0005Mask |= 0x1
-				default:
-					err = dc.Skip()
-					if err != nil {
-						err = msgp.WrapError(err, "Objects")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0005Mask & 0x1) == 0 {
-				z.Objects.Error = ""
-			}
-
 			zb0001Mask |= 0x80
 		case "versions":
-			var zb0006 uint32
-			zb0006, err = dc.ReadMapHeader()
+			err = z.Versions.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Versions")
 				return
 			}
-			var zb0006Mask uint8 /* 1 bits */
-			_ = zb0006Mask
-			for zb0006 > 0 {
-				zb0006--
-				field, err = dc.ReadMapKeyPtr()
-				if err != nil {
-					err = msgp.WrapError(err, "Versions")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Versions.Count, err = dc.ReadUint64()
-					if err != nil {
-						err = msgp.WrapError(err, "Versions", "Count")
-						return
-					}
-				case "error":
-					z.Versions.Error, err = dc.ReadString()
-					if err != nil {
-						err = msgp.WrapError(err, "Versions", "Error")
-						return
-					}
-					zb0006Mask |= 0x1
-				default:
-					err = dc.Skip()
-					if err != nil {
-						err = msgp.WrapError(err, "Versions")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0006Mask & 0x1) == 0 {
-				z.Versions.Error = ""
-			}
-
 			zb0001Mask |= 0x100
 		case "deletemarkers":
-			var zb0007 uint32
-			zb0007, err = dc.ReadMapHeader()
+			err = z.DeleteMarkers.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "DeleteMarkers")
 				return
 			}
-			var zb0007Mask uint8 /* 1 bits */
-			_ = zb0007Mask
-			for zb0007 > 0 {
-				zb0007--
-				field, err = dc.ReadMapKeyPtr()
-				if err != nil {
-					err = msgp.WrapError(err, "DeleteMarkers")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.DeleteMarkers.Count, err = dc.ReadUint64()
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers", "Count")
-						return
-					}
-				case "error":
-					z.DeleteMarkers.Error, err = dc.ReadString()
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers", "Error")
-						return
-					}
-					zb0007Mask |= 0x1
-				default:
-					err = dc.Skip()
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0007Mask & 0x1) == 0 {
-				z.DeleteMarkers.Error = ""
-			}
-
 			zb0001Mask |= 0x200
 		case "usage":
-			var zb0008 uint32
-			zb0008, err = dc.ReadMapHeader()
+			err = z.Usage.DecodeMsg(dc)
 			if err != nil {
 				err = msgp.WrapError(err, "Usage")
 				return
 			}
-			var zb0008Mask uint8 /* 1 bits */
-			_ = zb0008Mask
-			for zb0008 > 0 {
-				zb0008--
-				field, err = dc.ReadMapKeyPtr()
-				if err != nil {
-					err = msgp.WrapError(err, "Usage")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "size":
-					z.Usage.Size, err = dc.ReadUint64()
-					if err != nil {
-						err = msgp.WrapError(err, "Usage", "Size")
-						return
-					}
-				case "error":
-					z.Usage.Error, err = dc.ReadString()
-					if err != nil {
-						err = msgp.WrapError(err, "Usage", "Error")
-						return
-					}
-					zb0008Mask |= 0x1
-				default:
-					err = dc.Skip()
-					if err != nil {
-						err = msgp.WrapError(err, "Usage")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0008Mask & 0x1) == 0 {
-				z.Usage.Error = ""
-			}
-
 			zb0001Mask |= 0x400
 		case "services":
 			err = z.Services.DecodeMsg(dc)
@@ -8838,16 +8669,16 @@ func (z *InfoMessage) DecodeMsg(dc *msgp.Reader) (err error) {
 			}
 			zb0001Mask |= 0x1000
 		case "servers":
-			var zb0009 uint32
-			zb0009, err = dc.ReadArrayHeader()
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
 			if err != nil {
 				err = msgp.WrapError(err, "Servers")
 				return
 			}
-			if cap(z.Servers) >= int(zb0009) {
-				z.Servers = (z.Servers)[:zb0009]
+			if cap(z.Servers) >= int(zb0004) {
+				z.Servers = (z.Servers)[:zb0004]
 			} else {
-				z.Servers = make([]ServerProperties, zb0009)
+				z.Servers = make([]ServerProperties, zb0004)
 			}
 			for za0003 := range z.Servers {
 				err = z.Servers[za0003].DecodeMsg(dc)
@@ -8886,19 +8717,19 @@ func (z *InfoMessage) DecodeMsg(dc *msgp.Reader) (err error) {
 			z.ObjectNamingMode = ""
 		}
 		if (zb0001Mask & 0x40) == 0 {
-			z.Buckets = (Buckets{})
+			z.Buckets = Buckets{}
 		}
 		if (zb0001Mask & 0x80) == 0 {
-			z.Objects = (Objects{})
+			z.Objects = Objects{}
 		}
 		if (zb0001Mask & 0x100) == 0 {
-			z.Versions = (Versions{})
+			z.Versions = Versions{}
 		}
 		if (zb0001Mask & 0x200) == 0 {
-			z.DeleteMarkers = (DeleteMarkers{})
+			z.DeleteMarkers = DeleteMarkers{}
 		}
 		if (zb0001Mask & 0x400) == 0 {
-			z.Usage = (Usage{})
+			z.Usage = Usage{}
 		}
 		if (zb0001Mask & 0x800) == 0 {
 			z.Services = Services{}
@@ -8943,23 +8774,23 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if z.Buckets == (Buckets{}) {
+	if z.Buckets.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.Objects == (Objects{}) {
+	if z.Objects.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if z.Versions == (Versions{}) {
+	if z.Versions.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
-	if z.DeleteMarkers == (DeleteMarkers{}) {
+	if z.DeleteMarkers.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
-	if z.Usage == (Usage{}) {
+	if z.Usage.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x400
 	}
@@ -9067,45 +8898,11 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 			if err != nil {
 				return
 			}
-			// check for omitted fields
-			zb0002Len := uint32(2)
-			var zb0002Mask uint8 /* 2 bits */
-			_ = zb0002Mask
-			if z.Buckets.Error == "" {
-				zb0002Len--
-				zb0002Mask |= 0x2
-			}
-			// variable map header, size zb0002Len
-			err = en.Append(0x80 | uint8(zb0002Len))
+			err = z.Buckets.EncodeMsg(en)
 			if err != nil {
+				err = msgp.WrapError(err, "Buckets")
 				return
 			}
-
-			// skip if no fields are to be emitted
-			if zb0002Len != 0 {
-				// write "count"
-				err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				if err != nil {
-					return
-				}
-				err = en.WriteUint64(z.Buckets.Count)
-				if err != nil {
-					err = msgp.WrapError(err, "Buckets", "Count")
-					return
-				}
-				if (zb0002Mask & 0x2) == 0 { // if not omitted
-					// write "error"
-					err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					if err != nil {
-						return
-					}
-					err = en.WriteString(z.Buckets.Error)
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets", "Error")
-						return
-					}
-				}
-			}
 		}
 		if (zb0001Mask & 0x80) == 0 { // if not omitted
 			// write "objects"
@@ -9113,45 +8910,11 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 			if err != nil {
 				return
 			}
-			// check for omitted fields
-			zb0003Len := uint32(2)
-			var zb0003Mask uint8 /* 2 bits */
-			_ = zb0003Mask
-			if z.Objects.Error == "" {
-				zb0003Len--
-				zb0003Mask |= 0x2
-			}
-			// variable map header, size zb0003Len
-			err = en.Append(0x80 | uint8(zb0003Len))
+			err = z.Objects.EncodeMsg(en)
 			if err != nil {
+				err = msgp.WrapError(err, "Objects")
 				return
 			}
-
-			// skip if no fields are to be emitted
-			if zb0003Len != 0 {
-				// write "count"
-				err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				if err != nil {
-					return
-				}
-				err = en.WriteUint64(z.Objects.Count)
-				if err != nil {
-					err = msgp.WrapError(err, "Objects", "Count")
-					return
-				}
-				if (zb0003Mask & 0x2) == 0 { // if not omitted
-					// write "error"
-					err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					if err != nil {
-						return
-					}
-					err = en.WriteString(z.Objects.Error)
-					if err != nil {
-						err = msgp.WrapError(err, "Objects", "Error")
-						return
-					}
-				}
-			}
 		}
 		if (zb0001Mask & 0x100) == 0 { // if not omitted
 			// write "versions"
@@ -9159,45 +8922,11 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 			if err != nil {
 				return
 			}
-			// check for omitted fields
-			zb0004Len := uint32(2)
-			var zb0004Mask uint8 /* 2 bits */
-			_ = zb0004Mask
-			if z.Versions.Error == "" {
-				zb0004Len--
-				zb0004Mask |= 0x2
-			}
-			// variable map header, size zb0004Len
-			err = en.Append(0x80 | uint8(zb0004Len))
+			err = z.Versions.EncodeMsg(en)
 			if err != nil {
+				err = msgp.WrapError(err, "Versions")
 				return
 			}
-
-			// skip if no fields are to be emitted
-			if zb0004Len != 0 {
-				// write "count"
-				err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				if err != nil {
-					return
-				}
-				err = en.WriteUint64(z.Versions.Count)
-				if err != nil {
-					err = msgp.WrapError(err, "Versions", "Count")
-					return
-				}
-				if (zb0004Mask & 0x2) == 0 { // if not omitted
-					// write "error"
-					err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					if err != nil {
-						return
-					}
-					err = en.WriteString(z.Versions.Error)
-					if err != nil {
-						err = msgp.WrapError(err, "Versions", "Error")
-						return
-					}
-				}
-			}
 		}
 		if (zb0001Mask & 0x200) == 0 { // if not omitted
 			// write "deletemarkers"
@@ -9205,45 +8934,11 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 			if err != nil {
 				return
 			}
-			// check for omitted fields
-			zb0005Len := uint32(2)
-			var zb0005Mask uint8 /* 2 bits */
-			_ = zb0005Mask
-			if z.DeleteMarkers.Error == "" {
-				zb0005Len--
-				zb0005Mask |= 0x2
-			}
-			// variable map header, size zb0005Len
-			err = en.Append(0x80 | uint8(zb0005Len))
+			err = z.DeleteMarkers.EncodeMsg(en)
 			if err != nil {
+				err = msgp.WrapError(err, "DeleteMarkers")
 				return
 			}
-
-			// skip if no fields are to be emitted
-			if zb0005Len != 0 {
-				// write "count"
-				err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				if err != nil {
-					return
-				}
-				err = en.WriteUint64(z.DeleteMarkers.Count)
-				if err != nil {
-					err = msgp.WrapError(err, "DeleteMarkers", "Count")
-					return
-				}
-				if (zb0005Mask & 0x2) == 0 { // if not omitted
-					// write "error"
-					err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					if err != nil {
-						return
-					}
-					err = en.WriteString(z.DeleteMarkers.Error)
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers", "Error")
-						return
-					}
-				}
-			}
 		}
 		if (zb0001Mask & 0x400) == 0 { // if not omitted
 			// write "usage"
@@ -9251,45 +8946,11 @@ func (z *InfoMessage) EncodeMsg(en *msgp.Writer) (err error) {
 			if err != nil {
 				return
 			}
-			// check for omitted fields
-			zb0006Len := uint32(2)
-			var zb0006Mask uint8 /* 2 bits */
-			_ = zb0006Mask
-			if z.Usage.Error == "" {
-				zb0006Len--
-				zb0006Mask |= 0x2
-			}
-			// variable map header, size zb0006Len
-			err = en.Append(0x80 | uint8(zb0006Len))
+			err = z.Usage.EncodeMsg(en)
 			if err != nil {
+				err = msgp.WrapError(err, "Usage")
 				return
 			}
-
-			// skip if no fields are to be emitted
-			if zb0006Len != 0 {
-				// write "size"
-				err = en.Append(0xa4, 0x73, 0x69, 0x7a, 0x65)
-				if err != nil {
-					return
-				}
-				err = en.WriteUint64(z.Usage.Size)
-				if err != nil {
-					err = msgp.WrapError(err, "Usage", "Size")
-					return
-				}
-				if (zb0006Mask & 0x2) == 0 { // if not omitted
-					// write "error"
-					err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					if err != nil {
-						return
-					}
-					err = en.WriteString(z.Usage.Error)
-					if err != nil {
-						err = msgp.WrapError(err, "Usage", "Error")
-						return
-					}
-				}
-			}
 		}
 		// write "services"
 		err = en.Append(0xa8, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73)
@@ -9365,23 +9026,23 @@ func (z *InfoMessage) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if z.Buckets == (Buckets{}) {
+	if z.Buckets.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if z.Objects == (Objects{}) {
+	if z.Objects.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if z.Versions == (Versions{}) {
+	if z.Versions.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
-	if z.DeleteMarkers == (DeleteMarkers{}) {
+	if z.DeleteMarkers.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
-	if z.Usage == (Usage{}) {
+	if z.Usage.IsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x400
 	}
@@ -9433,131 +9094,46 @@ func (z *InfoMessage) MarshalMsg(b []byte) (o []byte, err error) {
 		if (zb0001Mask & 0x40) == 0 { // if not omitted
 			// string "buckets"
 			o = append(o, 0xa7, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
-			// check for omitted fields
-			zb0002Len := uint32(2)
-			var zb0002Mask uint8 /* 2 bits */
-			_ = zb0002Mask
-			if z.Buckets.Error == "" {
-				zb0002Len--
-				zb0002Mask |= 0x2
-			}
-			// variable map header, size zb0002Len
-			o = append(o, 0x80|uint8(zb0002Len))
-
-			// skip if no fields are to be emitted
-			if zb0002Len != 0 {
-				// string "count"
-				o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				o = msgp.AppendUint64(o, z.Buckets.Count)
-				if (zb0002Mask & 0x2) == 0 { // if not omitted
-					// string "error"
-					o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					o = msgp.AppendString(o, z.Buckets.Error)
-				}
+			o, err = z.Buckets.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Buckets")
+				return
 			}
 		}
 		if (zb0001Mask & 0x80) == 0 { // if not omitted
 			// string "objects"
 			o = append(o, 0xa7, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
-			// check for omitted fields
-			zb0003Len := uint32(2)
-			var zb0003Mask uint8 /* 2 bits */
-			_ = zb0003Mask
-			if z.Objects.Error == "" {
-				zb0003Len--
-				zb0003Mask |= 0x2
-			}
-			// variable map header, size zb0003Len
-			o = append(o, 0x80|uint8(zb0003Len))
-
-			// skip if no fields are to be emitted
-			if zb0003Len != 0 {
-				// string "count"
-				o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				o = msgp.AppendUint64(o, z.Objects.Count)
-				if (zb0003Mask & 0x2) == 0 { // if not omitted
-					// string "error"
-					o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					o = msgp.AppendString(o, z.Objects.Error)
-				}
+			o, err = z.Objects.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Objects")
+				return
 			}
 		}
 		if (zb0001Mask & 0x100) == 0 { // if not omitted
 			// string "versions"
 			o = append(o, 0xa8, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
-			// check for omitted fields
-			zb0004Len := uint32(2)
-			var zb0004Mask uint8 /* 2 bits */
-			_ = zb0004Mask
-			if z.Versions.Error == "" {
-				zb0004Len--
-				zb0004Mask |= 0x2
-			}
-			// variable map header, size zb0004Len
-			o = append(o, 0x80|uint8(zb0004Len))
-
-			// skip if no fields are to be emitted
-			if zb0004Len != 0 {
-				// string "count"
-				o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				o = msgp.AppendUint64(o, z.Versions.Count)
-				if (zb0004Mask & 0x2) == 0 { // if not omitted
-					// string "error"
-					o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					o = msgp.AppendString(o, z.Versions.Error)
-				}
+			o, err = z.Versions.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Versions")
+				return
 			}
 		}
 		if (zb0001Mask & 0x200) == 0 { // if not omitted
 			// string "deletemarkers"
 			o = append(o, 0xad, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x72, 0x73)
-			// check for omitted fields
-			zb0005Len := uint32(2)
-			var zb0005Mask uint8 /* 2 bits */
-			_ = zb0005Mask
-			if z.DeleteMarkers.Error == "" {
-				zb0005Len--
-				zb0005Mask |= 0x2
-			}
-			// variable map header, size zb0005Len
-			o = append(o, 0x80|uint8(zb0005Len))
-
-			// skip if no fields are to be emitted
-			if zb0005Len != 0 {
-				// string "count"
-				o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-				o = msgp.AppendUint64(o, z.DeleteMarkers.Count)
-				if (zb0005Mask & 0x2) == 0 { // if not omitted
-					// string "error"
-					o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					o = msgp.AppendString(o, z.DeleteMarkers.Error)
-				}
+			o, err = z.DeleteMarkers.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "DeleteMarkers")
+				return
 			}
 		}
 		if (zb0001Mask & 0x400) == 0 { // if not omitted
 			// string "usage"
 			o = append(o, 0xa5, 0x75, 0x73, 0x61, 0x67, 0x65)
-			// check for omitted fields
-			zb0006Len := uint32(2)
-			var zb0006Mask uint8 /* 2 bits */
-			_ = zb0006Mask
-			if z.Usage.Error == "" {
-				zb0006Len--
-				zb0006Mask |= 0x2
-			}
-			// variable map header, size zb0006Len
-			o = append(o, 0x80|uint8(zb0006Len))
-
-			// skip if no fields are to be emitted
-			if zb0006Len != 0 {
-				// string "size"
-				o = append(o, 0xa4, 0x73, 0x69, 0x7a, 0x65)
-				o = msgp.AppendUint64(o, z.Usage.Size)
-				if (zb0006Mask & 0x2) == 0 { // if not omitted
-					// string "error"
-					o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-					o = msgp.AppendString(o, z.Usage.Error)
-				}
+			o, err = z.Usage.MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Usage")
+				return
 			}
 		}
 		// string "services"
@@ -9677,226 +9253,41 @@ func (z *InfoMessage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ObjectNamingMode")
 				return
 			}
-			zb0001Mask |= 0x20
-		case "buckets":
-			var zb0004 uint32
-			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Buckets")
-				return
-			}
-			var zb0004Mask uint8 /* 1 bits */
-			_ = zb0004Mask
-			for zb0004 > 0 {
-				zb0004--
-				field, bts, err = msgp.ReadMapKeyZC(bts)
-				if err != nil {
-					err = msgp.WrapError(err, "Buckets")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Buckets.Count, bts, err = msgp.ReadUint64Bytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets", "Count")
-						return
-					}
-				case "error":
-					z.Buckets.Error, bts, err = msgp.ReadStringBytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets", "Error")
-						return
-					}
-					zb0004Mask |= 0x1
-				default:
-					bts, err = msgp.Skip(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Buckets")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0004Mask & 0x1) == 0 {
-				z.Buckets.Error = ""
+			zb0001Mask |= 0x20
+		case "buckets":
+			bts, err = z.Buckets.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Buckets")
+				return
 			}
-
 			zb0001Mask |= 0x40
 		case "objects":
-			var zb0005 uint32
-			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			bts, err = z.Objects.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Objects")
 				return
 			}
-			var zb0005Mask uint8 /* 1 bits */
-			_ = zb0005Mask
-			for zb0005 > 0 {
-				zb0005--
-				field, bts, err = msgp.ReadMapKeyZC(bts)
-				if err != nil {
-					err = msgp.WrapError(err, "Objects")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Objects.Count, bts, err = msgp.ReadUint64Bytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Objects", "Count")
-						return
-					}
-				case "error":
-					z.Objects.Error, bts, err = msgp.ReadStringBytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Objects", "Error")
-						return
-					}
-					zb0005Mask |= 0x1
-				default:
-					bts, err = msgp.Skip(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Objects")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0005Mask & 0x1) == 0 {
-				z.Objects.Error = ""
-			}
-
 			zb0001Mask |= 0x80
 		case "versions":
-			var zb0006 uint32
-			zb0006, bts, err = msgp.ReadMapHeaderBytes(bts)
+			bts, err = z.Versions.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Versions")
 				return
 			}
-			var zb0006Mask uint8 /* 1 bits */
-			_ = zb0006Mask
-			for zb0006 > 0 {
-				zb0006--
-				field, bts, err = msgp.ReadMapKeyZC(bts)
-				if err != nil {
-					err = msgp.WrapError(err, "Versions")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.Versions.Count, bts, err = msgp.ReadUint64Bytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Versions", "Count")
-						return
-					}
-				case "error":
-					z.Versions.Error, bts, err = msgp.ReadStringBytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Versions", "Error")
-						return
-					}
-					zb0006Mask |= 0x1
-				default:
-					bts, err = msgp.Skip(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Versions")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0006Mask & 0x1) == 0 {
-				z.Versions.Error = ""
-			}
-
 			zb0001Mask |= 0x100
 		case "deletemarkers":
-			var zb0007 uint32
-			zb0007, bts, err = msgp.ReadMapHeaderBytes(bts)
+			bts, err = z.DeleteMarkers.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "DeleteMarkers")
 				return
 			}
-			var zb0007Mask uint8 /* 1 bits */
-			_ = zb0007Mask
-			for zb0007 > 0 {
-				zb0007--
-				field, bts, err = msgp.ReadMapKeyZC(bts)
-				if err != nil {
-					err = msgp.WrapError(err, "DeleteMarkers")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "count":
-					z.DeleteMarkers.Count, bts, err = msgp.ReadUint64Bytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers", "Count")
-						return
-					}
-				case "error":
-					z.DeleteMarkers.Error, bts, err = msgp.ReadStringBytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers", "Error")
-						return
-					}
-					zb0007Mask |= 0x1
-				default:
-					bts, err = msgp.Skip(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "DeleteMarkers")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0007Mask & 0x1) == 0 {
-				z.DeleteMarkers.Error = ""
-			}
-
 			zb0001Mask |= 0x200
 		case "usage":
-			var zb0008 uint32
-			zb0008, bts, err = msgp.ReadMapHeaderBytes(bts)
+			bts, err = z.Usage.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Usage")
 				return
 			}
-			var zb0008Mask uint8 /* 1 bits */
-			_ = zb0008Mask
-			for zb0008 > 0 {
-				zb0008--
-				field, bts, err = msgp.ReadMapKeyZC(bts)
-				if err != nil {
-					err = msgp.WrapError(err, "Usage")
-					return
-				}
-				switch msgp.UnsafeString(field) {
-				case "size":
-					z.Usage.Size, bts, err = msgp.ReadUint64Bytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Usage", "Size")
-						return
-					}
-				case "error":
-					z.Usage.Error, bts, err = msgp.ReadStringBytes(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Usage", "Error")
-						return
-					}
-					zb0008Mask |= 0x1
-				default:
-					bts, err = msgp.Skip(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "Usage")
-						return
-					}
-				}
-			}
-			// Clear omitted fields.
-			if (zb0008Mask & 0x1) == 0 {
-				z.Usage.Error = ""
-			}
-
 			zb0001Mask |= 0x400
 		case "services":
 			bts, err = z.Services.UnmarshalMsg(bts)
@@ -9913,16 +9304,16 @@ func (z *InfoMessage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			}
 			zb0001Mask |= 0x1000
 		case "servers":
-			var zb0009 uint32
-			zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Servers")
 				return
 			}
-			if cap(z.Servers) >= int(zb0009) {
-				z.Servers = (z.Servers)[:zb0009]
+			if cap(z.Servers) >= int(zb0004) {
+				z.Servers = (z.Servers)[:zb0004]
 			} else {
-				z.Servers = make([]ServerProperties, zb0009)
+				z.Servers = make([]ServerProperties, zb0004)
 			}
 			for za0003 := range z.Servers {
 				bts, err = z.Servers[za0003].UnmarshalMsg(bts)
@@ -9961,19 +9352,19 @@ func (z *InfoMessage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			z.ObjectNamingMode = ""
 		}
 		if (zb0001Mask & 0x40) == 0 {
-			z.Buckets = (Buckets{})
+			z.Buckets = Buckets{}
 		}
 		if (zb0001Mask & 0x80) == 0 {
-			z.Objects = (Objects{})
+			z.Objects = Objects{}
 		}
 		if (zb0001Mask & 0x100) == 0 {
-			z.Versions = (Versions{})
+			z.Versions = Versions{}
 		}
 		if (zb0001Mask & 0x200) == 0 {
-			z.DeleteMarkers = (DeleteMarkers{})
+			z.DeleteMarkers = DeleteMarkers{}
 		}
 		if (zb0001Mask & 0x400) == 0 {
-			z.Usage = (Usage{})
+			z.Usage = Usage{}
 		}
 		if (zb0001Mask & 0x800) == 0 {
 			z.Services = Services{}
@@ -9999,13 +9390,170 @@ func (z *InfoMessage) Msgsize() (s int) {
 	for za0002 := range z.SQSARN {
 		s += msgp.StringPrefixSize + len(z.SQSARN[za0002])
 	}
-	s += 13 + msgp.StringPrefixSize + len(z.DeploymentID) + 17 + msgp.StringPrefixSize + len(z.ObjectNamingMode) + 8 + 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Buckets.Error) + 8 + 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Objects.Error) + 9 + 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Versions.Error) + 14 + 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.DeleteMarkers.Error) + 6 + 1 + 5 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Usage.Error) + 9 + z.Services.Msgsize() + 8 + z.Backend.Msgsize() + 8 + msgp.ArrayHeaderSize
+	s += 13 + msgp.StringPrefixSize + len(z.DeploymentID) + 17 + msgp.StringPrefixSize + len(z.ObjectNamingMode) + 8 + z.Buckets.Msgsize() + 8 + z.Objects.Msgsize() + 9 + z.Versions.Msgsize() + 14 + z.DeleteMarkers.Msgsize() + 6 + z.Usage.Msgsize() + 9 + z.Services.Msgsize() + 8 + z.Backend.Msgsize() + 8 + msgp.ArrayHeaderSize
 	for za0003 := range z.Servers {
 		s += z.Servers[za0003].Msgsize()
 	}
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *InfoSnapshot) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "info":
+			err = z.Info.DecodeMsg(dc)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+		case "fetchedAt":
+			z.FetchedAt, err = dc.ReadTimeUTC()
+			if err != nil {
+				err = msgp.WrapError(err, "FetchedAt")
+				return
+			}
+		case "source":
+			z.Source, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Source")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *InfoSnapshot) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "info"
+	err = en.Append(0x83, 0xa4, 0x69, 0x6e, 0x66, 0x6f)
+	if err != nil {
+		return
+	}
+	err = z.Info.EncodeMsg(en)
+	if err != nil {
+		err = msgp.WrapError(err, "Info")
+		return
+	}
+	// write "fetchedAt"
+	err = en.Append(0xa9, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.FetchedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "FetchedAt")
+		return
+	}
+	// write "source"
+	err = en.Append(0xa6, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Source)
+	if err != nil {
+		err = msgp.WrapError(err, "Source")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *InfoSnapshot) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "info"
+	o = append(o, 0x83, 0xa4, 0x69, 0x6e, 0x66, 0x6f)
+	o, err = z.Info.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Info")
+		return
+	}
+	// string "fetchedAt"
+	o = append(o, 0xa9, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.FetchedAt)
+	// string "source"
+	o = append(o, 0xa6, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65)
+	o = msgp.AppendString(o, z.Source)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *InfoSnapshot) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "info":
+			bts, err = z.Info.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Info")
+				return
+			}
+		case "fetchedAt":
+			z.FetchedAt, bts, err = msgp.ReadTimeUTCBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FetchedAt")
+				return
+			}
+		case "source":
+			z.Source, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Source")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *InfoSnapshot) Msgsize() (s int) {
+	s = 1 + 5 + z.Info.Msgsize() + 10 + msgp.TimeSize + 7 + msgp.StringPrefixSize + len(z.Source)
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *ItemState) DecodeMsg(dc *msgp.Reader) (err error) {
 	{
@@ -10536,6 +10084,58 @@ func (z LDAP) Msgsize() (s int) {
 	return
 }
 
+// DecodeMsg implements msgp.Decodable
+func (z *LeaderState) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = LeaderState(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z LeaderState) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteString(string(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z LeaderState) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendString(o, string(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *LeaderState) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = LeaderState(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z LeaderState) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *Logger) DecodeMsg(dc *msgp.Reader) (err error) {
 	var zb0003 uint32
@@ -10950,259 +10550,418 @@ func (z *MemStats) Msgsize() (s int) {
 }
 
 // DecodeMsg implements msgp.Decodable
-func (z *Objects) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
+func (z *PoolPhase) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, err = dc.ReadUint64()
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, err = dc.ReadString()
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
+		(*z) = PoolPhase(zb0001)
 	}
-
 	return
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z Objects) EncodeMsg(en *msgp.Writer) (err error) {
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
+func (z PoolPhase) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteString(string(z))
 	if err != nil {
+		err = msgp.WrapError(err)
 		return
 	}
+	return
+}
 
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// write "count"
-		err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+// MarshalMsg implements msgp.Marshaler
+func (z PoolPhase) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendString(o, string(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *PoolPhase) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
 		if err != nil {
+			err = msgp.WrapError(err)
 			return
 		}
-		err = en.WriteUint64(z.Count)
+		(*z) = PoolPhase(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z PoolPhase) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ReplicationRole) DecodeMsg(dc *msgp.Reader) (err error) {
+	{
+		var zb0001 string
+		zb0001, err = dc.ReadString()
 		if err != nil {
-			err = msgp.WrapError(err, "Count")
+			err = msgp.WrapError(err)
 			return
 		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// write "error"
-			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			if err != nil {
-				return
-			}
-			err = en.WriteString(z.Error)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-		}
+		(*z) = ReplicationRole(zb0001)
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z ReplicationRole) EncodeMsg(en *msgp.Writer) (err error) {
+	err = en.WriteString(string(z))
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z Objects) MarshalMsg(b []byte) (o []byte, err error) {
+func (z ReplicationRole) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
+	o = msgp.AppendString(o, string(z))
+	return
+}
 
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// string "count"
-		o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		o = msgp.AppendUint64(o, z.Count)
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// string "error"
-			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			o = msgp.AppendString(o, z.Error)
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ReplicationRole) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
 		}
+		(*z) = ReplicationRole(zb0001)
 	}
+	o = bts
 	return
 }
 
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *Objects) UnmarshalMsg(bts []byte) (o []byte, err error) {
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z ReplicationRole) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *ServerInfoOpts) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	zb0001, err = dc.ReadMapHeader()
 	if err != nil {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
+		field, err = dc.ReadMapKeyPtr()
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, bts, err = msgp.ReadUint64Bytes(bts)
+		case "Uncached":
+			z.Uncached, err = dc.ReadBool()
 			if err != nil {
-				err = msgp.WrapError(err, "Count")
+				err = msgp.WrapError(err, "Uncached")
 				return
 			}
-		case "error":
-			z.Error, bts, err = msgp.ReadStringBytes(bts)
+		case "Metrics":
+			z.Metrics, err = dc.ReadBool()
 			if err != nil {
-				err = msgp.WrapError(err, "Error")
+				err = msgp.WrapError(err, "Metrics")
+				return
+			}
+		case "FilterPool":
+			z.FilterPool, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "FilterPool")
+				return
+			}
+		case "FilterSet":
+			z.FilterSet, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "FilterSet")
+				return
+			}
+		case "Pool":
+			z.Pool, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Pool")
+				return
+			}
+		case "Set":
+			z.Set, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "Set")
+				return
+			}
+		case "Hosts":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "Hosts")
+				return
+			}
+			if cap(z.Hosts) >= int(zb0002) {
+				z.Hosts = (z.Hosts)[:zb0002]
+			} else {
+				z.Hosts = make([]string, zb0002)
+			}
+			for za0001 := range z.Hosts {
+				z.Hosts[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "Hosts", za0001)
+					return
+				}
+			}
+		case "Msgpack":
+			z.Msgpack, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Msgpack")
+				return
+			}
+		case "RetryAttempts":
+			z.RetryAttempts, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "RetryAttempts")
+				return
+			}
+		case "RetryBase":
+			z.RetryBase, err = dc.ReadDuration()
+			if err != nil {
+				err = msgp.WrapError(err, "RetryBase")
+				return
+			}
+		case "DeploymentID":
+			z.DeploymentID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "DeploymentID")
+				return
+			}
+		case "DisallowUnknownFields":
+			z.DisallowUnknownFields, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "DisallowUnknownFields")
+				return
+			}
+		case "RedactEnv":
+			z.RedactEnv, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "RedactEnv")
 				return
 			}
-			zb0001Mask |= 0x1
 		default:
-			bts, err = msgp.Skip(bts)
+			err = dc.Skip()
 			if err != nil {
 				err = msgp.WrapError(err)
 				return
 			}
 		}
 	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	o = bts
-	return
-}
-
-// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z Objects) Msgsize() (s int) {
-	s = 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Error)
 	return
 }
 
-// DecodeMsg implements msgp.Decodable
-func (z *ServerInfoOpts) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
+// EncodeMsg implements msgp.Encodable
+func (z *ServerInfoOpts) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 13
+	// write "Uncached"
+	err = en.Append(0x8d, 0xa8, 0x55, 0x6e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Uncached)
+	if err != nil {
+		err = msgp.WrapError(err, "Uncached")
+		return
+	}
+	// write "Metrics"
+	err = en.Append(0xa7, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Metrics)
+	if err != nil {
+		err = msgp.WrapError(err, "Metrics")
+		return
+	}
+	// write "FilterPool"
+	err = en.Append(0xaa, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.FilterPool)
+	if err != nil {
+		err = msgp.WrapError(err, "FilterPool")
+		return
+	}
+	// write "FilterSet"
+	err = en.Append(0xa9, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.FilterSet)
+	if err != nil {
+		err = msgp.WrapError(err, "FilterSet")
+		return
+	}
+	// write "Pool"
+	err = en.Append(0xa4, 0x50, 0x6f, 0x6f, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Pool)
+	if err != nil {
+		err = msgp.WrapError(err, "Pool")
+		return
+	}
+	// write "Set"
+	err = en.Append(0xa3, 0x53, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Set)
+	if err != nil {
+		err = msgp.WrapError(err, "Set")
+		return
+	}
+	// write "Hosts"
+	err = en.Append(0xa5, 0x48, 0x6f, 0x73, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Hosts)))
+	if err != nil {
+		err = msgp.WrapError(err, "Hosts")
+		return
+	}
+	for za0001 := range z.Hosts {
+		err = en.WriteString(z.Hosts[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "Hosts", za0001)
+			return
+		}
+	}
+	// write "Msgpack"
+	err = en.Append(0xa7, 0x4d, 0x73, 0x67, 0x70, 0x61, 0x63, 0x6b)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Msgpack)
+	if err != nil {
+		err = msgp.WrapError(err, "Msgpack")
+		return
+	}
+	// write "RetryAttempts"
+	err = en.Append(0xad, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.RetryAttempts)
 	if err != nil {
-		err = msgp.WrapError(err)
+		err = msgp.WrapError(err, "RetryAttempts")
 		return
 	}
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "Uncached":
-			z.Uncached, err = dc.ReadBool()
-			if err != nil {
-				err = msgp.WrapError(err, "Uncached")
-				return
-			}
-		case "Metrics":
-			z.Metrics, err = dc.ReadBool()
-			if err != nil {
-				err = msgp.WrapError(err, "Metrics")
-				return
-			}
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
+	// write "RetryBase"
+	err = en.Append(0xa9, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65)
+	if err != nil {
+		return
 	}
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z ServerInfoOpts) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
-	// write "Uncached"
-	err = en.Append(0x82, 0xa8, 0x55, 0x6e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64)
+	err = en.WriteDuration(z.RetryBase)
 	if err != nil {
+		err = msgp.WrapError(err, "RetryBase")
 		return
 	}
-	err = en.WriteBool(z.Uncached)
+	// write "DeploymentID"
+	err = en.Append(0xac, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44)
 	if err != nil {
-		err = msgp.WrapError(err, "Uncached")
 		return
 	}
-	// write "Metrics"
-	err = en.Append(0xa7, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73)
+	err = en.WriteString(z.DeploymentID)
 	if err != nil {
+		err = msgp.WrapError(err, "DeploymentID")
 		return
 	}
-	err = en.WriteBool(z.Metrics)
+	// write "DisallowUnknownFields"
+	err = en.Append(0xb5, 0x44, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73)
 	if err != nil {
-		err = msgp.WrapError(err, "Metrics")
+		return
+	}
+	err = en.WriteBool(z.DisallowUnknownFields)
+	if err != nil {
+		err = msgp.WrapError(err, "DisallowUnknownFields")
+		return
+	}
+	// write "RedactEnv"
+	err = en.Append(0xa9, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x76)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.RedactEnv)
+	if err != nil {
+		err = msgp.WrapError(err, "RedactEnv")
 		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z ServerInfoOpts) MarshalMsg(b []byte) (o []byte, err error) {
+func (z *ServerInfoOpts) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 13
 	// string "Uncached"
-	o = append(o, 0x82, 0xa8, 0x55, 0x6e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64)
+	o = append(o, 0x8d, 0xa8, 0x55, 0x6e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64)
 	o = msgp.AppendBool(o, z.Uncached)
 	// string "Metrics"
 	o = append(o, 0xa7, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73)
 	o = msgp.AppendBool(o, z.Metrics)
+	// string "FilterPool"
+	o = append(o, 0xaa, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x6f, 0x6f, 0x6c)
+	o = msgp.AppendBool(o, z.FilterPool)
+	// string "FilterSet"
+	o = append(o, 0xa9, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x65, 0x74)
+	o = msgp.AppendBool(o, z.FilterSet)
+	// string "Pool"
+	o = append(o, 0xa4, 0x50, 0x6f, 0x6f, 0x6c)
+	o = msgp.AppendInt(o, z.Pool)
+	// string "Set"
+	o = append(o, 0xa3, 0x53, 0x65, 0x74)
+	o = msgp.AppendInt(o, z.Set)
+	// string "Hosts"
+	o = append(o, 0xa5, 0x48, 0x6f, 0x73, 0x74, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Hosts)))
+	for za0001 := range z.Hosts {
+		o = msgp.AppendString(o, z.Hosts[za0001])
+	}
+	// string "Msgpack"
+	o = append(o, 0xa7, 0x4d, 0x73, 0x67, 0x70, 0x61, 0x63, 0x6b)
+	o = msgp.AppendBool(o, z.Msgpack)
+	// string "RetryAttempts"
+	o = append(o, 0xad, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73)
+	o = msgp.AppendInt(o, z.RetryAttempts)
+	// string "RetryBase"
+	o = append(o, 0xa9, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65)
+	o = msgp.AppendDuration(o, z.RetryBase)
+	// string "DeploymentID"
+	o = append(o, 0xac, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44)
+	o = msgp.AppendString(o, z.DeploymentID)
+	// string "DisallowUnknownFields"
+	o = append(o, 0xb5, 0x44, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73)
+	o = msgp.AppendBool(o, z.DisallowUnknownFields)
+	// string "RedactEnv"
+	o = append(o, 0xa9, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x76)
+	o = msgp.AppendBool(o, z.RedactEnv)
 	return
 }
 
@@ -11236,6 +10995,85 @@ func (z *ServerInfoOpts) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Metrics")
 				return
 			}
+		case "FilterPool":
+			z.FilterPool, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FilterPool")
+				return
+			}
+		case "FilterSet":
+			z.FilterSet, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FilterSet")
+				return
+			}
+		case "Pool":
+			z.Pool, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Pool")
+				return
+			}
+		case "Set":
+			z.Set, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Set")
+				return
+			}
+		case "Hosts":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Hosts")
+				return
+			}
+			if cap(z.Hosts) >= int(zb0002) {
+				z.Hosts = (z.Hosts)[:zb0002]
+			} else {
+				z.Hosts = make([]string, zb0002)
+			}
+			for za0001 := range z.Hosts {
+				z.Hosts[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hosts", za0001)
+					return
+				}
+			}
+		case "Msgpack":
+			z.Msgpack, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Msgpack")
+				return
+			}
+		case "RetryAttempts":
+			z.RetryAttempts, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RetryAttempts")
+				return
+			}
+		case "RetryBase":
+			z.RetryBase, bts, err = msgp.ReadDurationBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RetryBase")
+				return
+			}
+		case "DeploymentID":
+			z.DeploymentID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DeploymentID")
+				return
+			}
+		case "DisallowUnknownFields":
+			z.DisallowUnknownFields, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DisallowUnknownFields")
+				return
+			}
+		case "RedactEnv":
+			z.RedactEnv, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RedactEnv")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -11249,8 +11087,12 @@ func (z *ServerInfoOpts) UnmarshalMsg(bts []byte) (o []byte, err error) {
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z ServerInfoOpts) Msgsize() (s int) {
-	s = 1 + 9 + msgp.BoolSize + 8 + msgp.BoolSize
+func (z *ServerInfoOpts) Msgsize() (s int) {
+	s = 1 + 9 + msgp.BoolSize + 8 + msgp.BoolSize + 11 + msgp.BoolSize + 10 + msgp.BoolSize + 5 + msgp.IntSize + 4 + msgp.IntSize + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Hosts {
+		s += msgp.StringPrefixSize + len(z.Hosts[za0001])
+	}
+	s += 8 + msgp.BoolSize + 14 + msgp.IntSize + 10 + msgp.DurationSize + 13 + msgp.StringPrefixSize + len(z.DeploymentID) + 22 + msgp.BoolSize + 10 + msgp.BoolSize
 	return
 }
 
@@ -11264,7 +11106,7 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint32 /* 24 bits */
+	var zb0001Mask uint32 /* 25 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -11398,27 +11240,46 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "MemStats")
 				return
 			}
+		case "gc_stats":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+				z.GCStats = nil
+			} else {
+				if z.GCStats == nil {
+					z.GCStats = new(GCStats)
+				}
+				err = z.GCStats.DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+			zb0001Mask |= 0x400
 		case "go_max_procs":
 			z.GoMaxProcs, err = dc.ReadInt()
 			if err != nil {
 				err = msgp.WrapError(err, "GoMaxProcs")
 				return
 			}
-			zb0001Mask |= 0x400
+			zb0001Mask |= 0x800
 		case "num_cpu":
 			z.NumCPU, err = dc.ReadInt()
 			if err != nil {
 				err = msgp.WrapError(err, "NumCPU")
 				return
 			}
-			zb0001Mask |= 0x800
+			zb0001Mask |= 0x1000
 		case "runtime_version":
 			z.RuntimeVersion, err = dc.ReadString()
 			if err != nil {
 				err = msgp.WrapError(err, "RuntimeVersion")
 				return
 			}
-			zb0001Mask |= 0x1000
+			zb0001Mask |= 0x2000
 		case "minio_env_vars":
 			var zb0005 uint32
 			zb0005, err = dc.ReadMapHeader()
@@ -11447,14 +11308,14 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 				z.MinioEnvVars[za0005] = za0006
 			}
-			zb0001Mask |= 0x2000
+			zb0001Mask |= 0x4000
 		case "minio_env_hash":
 			z.MinioEnvHash, err = dc.ReadString()
 			if err != nil {
 				err = msgp.WrapError(err, "MinioEnvHash")
 				return
 			}
-			zb0001Mask |= 0x4000
+			zb0001Mask |= 0x8000
 		case "edition":
 			z.Edition, err = dc.ReadString()
 			if err != nil {
@@ -11479,7 +11340,7 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x8000
+			zb0001Mask |= 0x10000
 		case "is_leader":
 			z.IsLeader, err = dc.ReadBool()
 			if err != nil {
@@ -11510,35 +11371,35 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x10000
+			zb0001Mask |= 0x20000
 		case "pid":
 			z.PID, err = dc.ReadInt32()
 			if err != nil {
 				err = msgp.WrapError(err, "PID")
 				return
 			}
-			zb0001Mask |= 0x20000
+			zb0001Mask |= 0x40000
 		case "cmd_line":
 			z.CmdLine, err = dc.ReadString()
 			if err != nil {
 				err = msgp.WrapError(err, "CmdLine")
 				return
 			}
-			zb0001Mask |= 0x40000
+			zb0001Mask |= 0x80000
 		case "username":
 			z.Username, err = dc.ReadString()
 			if err != nil {
 				err = msgp.WrapError(err, "Username")
 				return
 			}
-			zb0001Mask |= 0x80000
+			zb0001Mask |= 0x100000
 		case "is_background":
 			z.IsBackground, err = dc.ReadBool()
 			if err != nil {
 				err = msgp.WrapError(err, "IsBackground")
 				return
 			}
-			zb0001Mask |= 0x100000
+			zb0001Mask |= 0x200000
 		case "first_cpu":
 			if dc.IsNil() {
 				err = dc.ReadNil()
@@ -11557,14 +11418,14 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x200000
+			zb0001Mask |= 0x400000
 		case "cpu_count":
 			z.CPUCount, err = dc.ReadInt()
 			if err != nil {
 				err = msgp.WrapError(err, "CPUCount")
 				return
 			}
-			zb0001Mask |= 0x400000
+			zb0001Mask |= 0x800000
 		case "api_version":
 			err = z.APIVersion.DecodeMsg(dc)
 			if err != nil {
@@ -11577,7 +11438,7 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 				err = msgp.WrapError(err, "RestartingSince")
 				return
 			}
-			zb0001Mask |= 0x800000
+			zb0001Mask |= 0x1000000
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -11587,7 +11448,7 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0xffffff {
+	if zb0001Mask != 0x1ffffff {
 		if (zb0001Mask & 0x1) == 0 {
 			z.State = ""
 		}
@@ -11619,45 +11480,48 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 			z.PoolNumbers = nil
 		}
 		if (zb0001Mask & 0x400) == 0 {
-			z.GoMaxProcs = 0
+			z.GCStats = nil
 		}
 		if (zb0001Mask & 0x800) == 0 {
-			z.NumCPU = 0
+			z.GoMaxProcs = 0
 		}
 		if (zb0001Mask & 0x1000) == 0 {
-			z.RuntimeVersion = ""
+			z.NumCPU = 0
 		}
 		if (zb0001Mask & 0x2000) == 0 {
-			z.MinioEnvVars = nil
+			z.RuntimeVersion = ""
 		}
 		if (zb0001Mask & 0x4000) == 0 {
-			z.MinioEnvHash = ""
+			z.MinioEnvVars = nil
 		}
 		if (zb0001Mask & 0x8000) == 0 {
-			z.License = nil
+			z.MinioEnvHash = ""
 		}
 		if (zb0001Mask & 0x10000) == 0 {
-			z.Host = nil
+			z.License = nil
 		}
 		if (zb0001Mask & 0x20000) == 0 {
-			z.PID = 0
+			z.Host = nil
 		}
 		if (zb0001Mask & 0x40000) == 0 {
-			z.CmdLine = ""
+			z.PID = 0
 		}
 		if (zb0001Mask & 0x80000) == 0 {
-			z.Username = ""
+			z.CmdLine = ""
 		}
 		if (zb0001Mask & 0x100000) == 0 {
-			z.IsBackground = false
+			z.Username = ""
 		}
 		if (zb0001Mask & 0x200000) == 0 {
-			z.FirstCPU = nil
+			z.IsBackground = false
 		}
 		if (zb0001Mask & 0x400000) == 0 {
-			z.CPUCount = 0
+			z.FirstCPU = nil
 		}
 		if (zb0001Mask & 0x800000) == 0 {
+			z.CPUCount = 0
+		}
+		if (zb0001Mask & 0x1000000) == 0 {
 			z.RestartingSince = (time.Time{})
 		}
 	}
@@ -11667,8 +11531,8 @@ func (z *ServerProperties) DecodeMsg(dc *msgp.Reader) (err error) {
 // EncodeMsg implements msgp.Encodable
 func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 	// check for omitted fields
-	zb0001Len := uint32(29)
-	var zb0001Mask uint32 /* 29 bits */
+	zb0001Len := uint32(30)
+	var zb0001Mask uint32 /* 30 bits */
 	_ = zb0001Mask
 	if z.State == "" {
 		zb0001Len--
@@ -11710,61 +11574,65 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
-	if z.GoMaxProcs == 0 {
+	if z.GCStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x800
 	}
-	if z.NumCPU == 0 {
+	if z.GoMaxProcs == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x1000
 	}
-	if z.RuntimeVersion == "" {
+	if z.NumCPU == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x2000
 	}
-	if z.MinioEnvVars == nil {
+	if z.RuntimeVersion == "" {
 		zb0001Len--
 		zb0001Mask |= 0x4000
 	}
-	if z.MinioEnvHash == "" {
+	if z.MinioEnvVars == nil {
 		zb0001Len--
 		zb0001Mask |= 0x8000
 	}
+	if z.MinioEnvHash == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
 	if z.License == nil {
 		zb0001Len--
-		zb0001Mask |= 0x20000
+		zb0001Mask |= 0x40000
 	}
 	if z.Host == nil {
 		zb0001Len--
-		zb0001Mask |= 0x100000
+		zb0001Mask |= 0x200000
 	}
 	if z.PID == 0 {
 		zb0001Len--
-		zb0001Mask |= 0x200000
+		zb0001Mask |= 0x400000
 	}
 	if z.CmdLine == "" {
 		zb0001Len--
-		zb0001Mask |= 0x400000
+		zb0001Mask |= 0x800000
 	}
 	if z.Username == "" {
 		zb0001Len--
-		zb0001Mask |= 0x800000
+		zb0001Mask |= 0x1000000
 	}
 	if z.IsBackground == false {
 		zb0001Len--
-		zb0001Mask |= 0x1000000
+		zb0001Mask |= 0x2000000
 	}
 	if z.FirstCPU == nil {
 		zb0001Len--
-		zb0001Mask |= 0x2000000
+		zb0001Mask |= 0x4000000
 	}
 	if z.CPUCount == 0 {
 		zb0001Len--
-		zb0001Mask |= 0x4000000
+		zb0001Mask |= 0x8000000
 	}
 	if z.RestartingSince == (time.Time{}) {
 		zb0001Len--
-		zb0001Mask |= 0x10000000
+		zb0001Mask |= 0x20000000
 	}
 	// variable map header, size zb0001Len
 	err = en.WriteMapHeader(zb0001Len)
@@ -11931,6 +11799,25 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 		if (zb0001Mask & 0x800) == 0 { // if not omitted
+			// write "gc_stats"
+			err = en.Append(0xa8, 0x67, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			if err != nil {
+				return
+			}
+			if z.GCStats == nil {
+				err = en.WriteNil()
+				if err != nil {
+					return
+				}
+			} else {
+				err = z.GCStats.EncodeMsg(en)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
 			// write "go_max_procs"
 			err = en.Append(0xac, 0x67, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x73)
 			if err != nil {
@@ -11942,7 +11829,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
 			// write "num_cpu"
 			err = en.Append(0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75)
 			if err != nil {
@@ -11954,7 +11841,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
 			// write "runtime_version"
 			err = en.Append(0xaf, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			if err != nil {
@@ -11966,7 +11853,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
 			// write "minio_env_vars"
 			err = en.Append(0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73)
 			if err != nil {
@@ -11990,7 +11877,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
-		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
 			// write "minio_env_hash"
 			err = en.Append(0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x68, 0x61, 0x73, 0x68)
 			if err != nil {
@@ -12012,7 +11899,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 			err = msgp.WrapError(err, "Edition")
 			return
 		}
-		if (zb0001Mask & 0x20000) == 0 { // if not omitted
+		if (zb0001Mask & 0x40000) == 0 { // if not omitted
 			// write "license"
 			err = en.Append(0xa7, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65)
 			if err != nil {
@@ -12051,7 +11938,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 			err = msgp.WrapError(err, "ILMExpiryInProgress")
 			return
 		}
-		if (zb0001Mask & 0x100000) == 0 { // if not omitted
+		if (zb0001Mask & 0x200000) == 0 { // if not omitted
 			// write "host"
 			err = en.Append(0xa4, 0x68, 0x6f, 0x73, 0x74)
 			if err != nil {
@@ -12070,7 +11957,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
-		if (zb0001Mask & 0x200000) == 0 { // if not omitted
+		if (zb0001Mask & 0x400000) == 0 { // if not omitted
 			// write "pid"
 			err = en.Append(0xa3, 0x70, 0x69, 0x64)
 			if err != nil {
@@ -12082,7 +11969,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x400000) == 0 { // if not omitted
+		if (zb0001Mask & 0x800000) == 0 { // if not omitted
 			// write "cmd_line"
 			err = en.Append(0xa8, 0x63, 0x6d, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65)
 			if err != nil {
@@ -12094,7 +11981,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x800000) == 0 { // if not omitted
+		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
 			// write "username"
 			err = en.Append(0xa8, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65)
 			if err != nil {
@@ -12106,7 +11993,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x2000000) == 0 { // if not omitted
 			// write "is_background"
 			err = en.Append(0xad, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64)
 			if err != nil {
@@ -12118,7 +12005,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				return
 			}
 		}
-		if (zb0001Mask & 0x2000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
 			// write "first_cpu"
 			err = en.Append(0xa9, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x70, 0x75)
 			if err != nil {
@@ -12137,7 +12024,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 				}
 			}
 		}
-		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x8000000) == 0 { // if not omitted
 			// write "cpu_count"
 			err = en.Append(0xa9, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74)
 			if err != nil {
@@ -12159,7 +12046,7 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 			err = msgp.WrapError(err, "APIVersion")
 			return
 		}
-		if (zb0001Mask & 0x10000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x20000000) == 0 { // if not omitted
 			// write "restarting_since"
 			err = en.Append(0xb0, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65)
 			if err != nil {
@@ -12179,8 +12066,8 @@ func (z *ServerProperties) EncodeMsg(en *msgp.Writer) (err error) {
 func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
 	// check for omitted fields
-	zb0001Len := uint32(29)
-	var zb0001Mask uint32 /* 29 bits */
+	zb0001Len := uint32(30)
+	var zb0001Mask uint32 /* 30 bits */
 	_ = zb0001Mask
 	if z.State == "" {
 		zb0001Len--
@@ -12222,61 +12109,65 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
-	if z.GoMaxProcs == 0 {
+	if z.GCStats == nil {
 		zb0001Len--
 		zb0001Mask |= 0x800
 	}
-	if z.NumCPU == 0 {
+	if z.GoMaxProcs == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x1000
 	}
-	if z.RuntimeVersion == "" {
+	if z.NumCPU == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x2000
 	}
-	if z.MinioEnvVars == nil {
+	if z.RuntimeVersion == "" {
 		zb0001Len--
 		zb0001Mask |= 0x4000
 	}
-	if z.MinioEnvHash == "" {
+	if z.MinioEnvVars == nil {
 		zb0001Len--
 		zb0001Mask |= 0x8000
 	}
+	if z.MinioEnvHash == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10000
+	}
 	if z.License == nil {
 		zb0001Len--
-		zb0001Mask |= 0x20000
+		zb0001Mask |= 0x40000
 	}
 	if z.Host == nil {
 		zb0001Len--
-		zb0001Mask |= 0x100000
+		zb0001Mask |= 0x200000
 	}
 	if z.PID == 0 {
 		zb0001Len--
-		zb0001Mask |= 0x200000
+		zb0001Mask |= 0x400000
 	}
 	if z.CmdLine == "" {
 		zb0001Len--
-		zb0001Mask |= 0x400000
+		zb0001Mask |= 0x800000
 	}
 	if z.Username == "" {
 		zb0001Len--
-		zb0001Mask |= 0x800000
+		zb0001Mask |= 0x1000000
 	}
 	if z.IsBackground == false {
 		zb0001Len--
-		zb0001Mask |= 0x1000000
+		zb0001Mask |= 0x2000000
 	}
 	if z.FirstCPU == nil {
 		zb0001Len--
-		zb0001Mask |= 0x2000000
+		zb0001Mask |= 0x4000000
 	}
 	if z.CPUCount == 0 {
 		zb0001Len--
-		zb0001Mask |= 0x4000000
+		zb0001Mask |= 0x8000000
 	}
 	if z.RestartingSince == (time.Time{}) {
 		zb0001Len--
-		zb0001Mask |= 0x10000000
+		zb0001Mask |= 0x20000000
 	}
 	// variable map header, size zb0001Len
 	o = msgp.AppendMapHeader(o, zb0001Len)
@@ -12355,21 +12246,34 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 			return
 		}
 		if (zb0001Mask & 0x800) == 0 { // if not omitted
+			// string "gc_stats"
+			o = append(o, 0xa8, 0x67, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73)
+			if z.GCStats == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.GCStats.MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+		}
+		if (zb0001Mask & 0x1000) == 0 { // if not omitted
 			// string "go_max_procs"
 			o = append(o, 0xac, 0x67, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x73)
 			o = msgp.AppendInt(o, z.GoMaxProcs)
 		}
-		if (zb0001Mask & 0x1000) == 0 { // if not omitted
+		if (zb0001Mask & 0x2000) == 0 { // if not omitted
 			// string "num_cpu"
 			o = append(o, 0xa7, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x70, 0x75)
 			o = msgp.AppendInt(o, z.NumCPU)
 		}
-		if (zb0001Mask & 0x2000) == 0 { // if not omitted
+		if (zb0001Mask & 0x4000) == 0 { // if not omitted
 			// string "runtime_version"
 			o = append(o, 0xaf, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendString(o, z.RuntimeVersion)
 		}
-		if (zb0001Mask & 0x4000) == 0 { // if not omitted
+		if (zb0001Mask & 0x8000) == 0 { // if not omitted
 			// string "minio_env_vars"
 			o = append(o, 0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73)
 			o = msgp.AppendMapHeader(o, uint32(len(z.MinioEnvVars)))
@@ -12378,7 +12282,7 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 				o = msgp.AppendString(o, za0006)
 			}
 		}
-		if (zb0001Mask & 0x8000) == 0 { // if not omitted
+		if (zb0001Mask & 0x10000) == 0 { // if not omitted
 			// string "minio_env_hash"
 			o = append(o, 0xae, 0x6d, 0x69, 0x6e, 0x69, 0x6f, 0x5f, 0x65, 0x6e, 0x76, 0x5f, 0x68, 0x61, 0x73, 0x68)
 			o = msgp.AppendString(o, z.MinioEnvHash)
@@ -12386,7 +12290,7 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 		// string "edition"
 		o = append(o, 0xa7, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e)
 		o = msgp.AppendString(o, z.Edition)
-		if (zb0001Mask & 0x20000) == 0 { // if not omitted
+		if (zb0001Mask & 0x40000) == 0 { // if not omitted
 			// string "license"
 			o = append(o, 0xa7, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65)
 			if z.License == nil {
@@ -12405,7 +12309,7 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 		// string "ilm_expiry_in_progress"
 		o = append(o, 0xb6, 0x69, 0x6c, 0x6d, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73)
 		o = msgp.AppendBool(o, z.ILMExpiryInProgress)
-		if (zb0001Mask & 0x100000) == 0 { // if not omitted
+		if (zb0001Mask & 0x200000) == 0 { // if not omitted
 			// string "host"
 			o = append(o, 0xa4, 0x68, 0x6f, 0x73, 0x74)
 			if z.Host == nil {
@@ -12418,27 +12322,27 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if (zb0001Mask & 0x200000) == 0 { // if not omitted
+		if (zb0001Mask & 0x400000) == 0 { // if not omitted
 			// string "pid"
 			o = append(o, 0xa3, 0x70, 0x69, 0x64)
 			o = msgp.AppendInt32(o, z.PID)
 		}
-		if (zb0001Mask & 0x400000) == 0 { // if not omitted
+		if (zb0001Mask & 0x800000) == 0 { // if not omitted
 			// string "cmd_line"
 			o = append(o, 0xa8, 0x63, 0x6d, 0x64, 0x5f, 0x6c, 0x69, 0x6e, 0x65)
 			o = msgp.AppendString(o, z.CmdLine)
 		}
-		if (zb0001Mask & 0x800000) == 0 { // if not omitted
+		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
 			// string "username"
 			o = append(o, 0xa8, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65)
 			o = msgp.AppendString(o, z.Username)
 		}
-		if (zb0001Mask & 0x1000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x2000000) == 0 { // if not omitted
 			// string "is_background"
 			o = append(o, 0xad, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64)
 			o = msgp.AppendBool(o, z.IsBackground)
 		}
-		if (zb0001Mask & 0x2000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
 			// string "first_cpu"
 			o = append(o, 0xa9, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x70, 0x75)
 			if z.FirstCPU == nil {
@@ -12451,7 +12355,7 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if (zb0001Mask & 0x4000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x8000000) == 0 { // if not omitted
 			// string "cpu_count"
 			o = append(o, 0xa9, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendInt(o, z.CPUCount)
@@ -12463,7 +12367,7 @@ func (z *ServerProperties) MarshalMsg(b []byte) (o []byte, err error) {
 			err = msgp.WrapError(err, "APIVersion")
 			return
 		}
-		if (zb0001Mask & 0x10000000) == 0 { // if not omitted
+		if (zb0001Mask & 0x20000000) == 0 { // if not omitted
 			// string "restarting_since"
 			o = append(o, 0xb0, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65)
 			o = msgp.AppendTime(o, z.RestartingSince)
@@ -12482,7 +12386,7 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint32 /* 24 bits */
+	var zb0001Mask uint32 /* 25 bits */
 	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
@@ -12616,27 +12520,45 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "MemStats")
 				return
 			}
+		case "gc_stats":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.GCStats = nil
+			} else {
+				if z.GCStats == nil {
+					z.GCStats = new(GCStats)
+				}
+				bts, err = z.GCStats.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "GCStats")
+					return
+				}
+			}
+			zb0001Mask |= 0x400
 		case "go_max_procs":
 			z.GoMaxProcs, bts, err = msgp.ReadIntBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "GoMaxProcs")
 				return
 			}
-			zb0001Mask |= 0x400
+			zb0001Mask |= 0x800
 		case "num_cpu":
 			z.NumCPU, bts, err = msgp.ReadIntBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "NumCPU")
 				return
 			}
-			zb0001Mask |= 0x800
+			zb0001Mask |= 0x1000
 		case "runtime_version":
 			z.RuntimeVersion, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "RuntimeVersion")
 				return
 			}
-			zb0001Mask |= 0x1000
+			zb0001Mask |= 0x2000
 		case "minio_env_vars":
 			var zb0005 uint32
 			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
@@ -12665,14 +12587,14 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.MinioEnvVars[za0005] = za0006
 			}
-			zb0001Mask |= 0x2000
+			zb0001Mask |= 0x4000
 		case "minio_env_hash":
 			z.MinioEnvHash, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "MinioEnvHash")
 				return
 			}
-			zb0001Mask |= 0x4000
+			zb0001Mask |= 0x8000
 		case "edition":
 			z.Edition, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
@@ -12696,7 +12618,7 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x8000
+			zb0001Mask |= 0x10000
 		case "is_leader":
 			z.IsLeader, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
@@ -12726,35 +12648,35 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x10000
+			zb0001Mask |= 0x20000
 		case "pid":
 			z.PID, bts, err = msgp.ReadInt32Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "PID")
 				return
 			}
-			zb0001Mask |= 0x20000
+			zb0001Mask |= 0x40000
 		case "cmd_line":
 			z.CmdLine, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "CmdLine")
 				return
 			}
-			zb0001Mask |= 0x40000
+			zb0001Mask |= 0x80000
 		case "username":
 			z.Username, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Username")
 				return
 			}
-			zb0001Mask |= 0x80000
+			zb0001Mask |= 0x100000
 		case "is_background":
 			z.IsBackground, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "IsBackground")
 				return
 			}
-			zb0001Mask |= 0x100000
+			zb0001Mask |= 0x200000
 		case "first_cpu":
 			if msgp.IsNil(bts) {
 				bts, err = msgp.ReadNilBytes(bts)
@@ -12772,14 +12694,14 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
-			zb0001Mask |= 0x200000
+			zb0001Mask |= 0x400000
 		case "cpu_count":
 			z.CPUCount, bts, err = msgp.ReadIntBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "CPUCount")
 				return
 			}
-			zb0001Mask |= 0x400000
+			zb0001Mask |= 0x800000
 		case "api_version":
 			bts, err = z.APIVersion.UnmarshalMsg(bts)
 			if err != nil {
@@ -12792,7 +12714,7 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "RestartingSince")
 				return
 			}
-			zb0001Mask |= 0x800000
+			zb0001Mask |= 0x1000000
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -12802,7 +12724,7 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		}
 	}
 	// Clear omitted fields.
-	if zb0001Mask != 0xffffff {
+	if zb0001Mask != 0x1ffffff {
 		if (zb0001Mask & 0x1) == 0 {
 			z.State = ""
 		}
@@ -12834,45 +12756,48 @@ func (z *ServerProperties) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			z.PoolNumbers = nil
 		}
 		if (zb0001Mask & 0x400) == 0 {
-			z.GoMaxProcs = 0
+			z.GCStats = nil
 		}
 		if (zb0001Mask & 0x800) == 0 {
-			z.NumCPU = 0
+			z.GoMaxProcs = 0
 		}
 		if (zb0001Mask & 0x1000) == 0 {
-			z.RuntimeVersion = ""
+			z.NumCPU = 0
 		}
 		if (zb0001Mask & 0x2000) == 0 {
-			z.MinioEnvVars = nil
+			z.RuntimeVersion = ""
 		}
 		if (zb0001Mask & 0x4000) == 0 {
-			z.MinioEnvHash = ""
+			z.MinioEnvVars = nil
 		}
 		if (zb0001Mask & 0x8000) == 0 {
-			z.License = nil
+			z.MinioEnvHash = ""
 		}
 		if (zb0001Mask & 0x10000) == 0 {
-			z.Host = nil
+			z.License = nil
 		}
 		if (zb0001Mask & 0x20000) == 0 {
-			z.PID = 0
+			z.Host = nil
 		}
 		if (zb0001Mask & 0x40000) == 0 {
-			z.CmdLine = ""
+			z.PID = 0
 		}
 		if (zb0001Mask & 0x80000) == 0 {
-			z.Username = ""
+			z.CmdLine = ""
 		}
 		if (zb0001Mask & 0x100000) == 0 {
-			z.IsBackground = false
+			z.Username = ""
 		}
 		if (zb0001Mask & 0x200000) == 0 {
-			z.FirstCPU = nil
+			z.IsBackground = false
 		}
 		if (zb0001Mask & 0x400000) == 0 {
-			z.CPUCount = 0
+			z.FirstCPU = nil
 		}
 		if (zb0001Mask & 0x800000) == 0 {
+			z.CPUCount = 0
+		}
+		if (zb0001Mask & 0x1000000) == 0 {
 			z.RestartingSince = (time.Time{})
 		}
 	}
@@ -12893,7 +12818,13 @@ func (z *ServerProperties) Msgsize() (s int) {
 	for za0003 := range z.Disks {
 		s += z.Disks[za0003].Msgsize()
 	}
-	s += 11 + msgp.IntSize + 12 + msgp.ArrayHeaderSize + (len(z.PoolNumbers) * (msgp.IntSize)) + 10 + z.MemStats.Msgsize() + 13 + msgp.IntSize + 8 + msgp.IntSize + 16 + msgp.StringPrefixSize + len(z.RuntimeVersion) + 15 + msgp.MapHeaderSize
+	s += 11 + msgp.IntSize + 12 + msgp.ArrayHeaderSize + (len(z.PoolNumbers) * (msgp.IntSize)) + 10 + z.MemStats.Msgsize() + 9
+	if z.GCStats == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.GCStats.Msgsize()
+	}
+	s += 13 + msgp.IntSize + 8 + msgp.IntSize + 16 + msgp.StringPrefixSize + len(z.RuntimeVersion) + 15 + msgp.MapHeaderSize
 	if z.MinioEnvVars != nil {
 		for za0005, za0006 := range z.MinioEnvVars {
 			_ = za0006
@@ -14904,169 +14835,19 @@ func (z *TierStats) DecodeMsg(dc *msgp.Reader) (err error) {
 			if err != nil {
 				err = msgp.WrapError(err, "TotalSize")
 				return
-			}
-		case "numVersions":
-			z.NumVersions, err = dc.ReadInt()
-			if err != nil {
-				err = msgp.WrapError(err, "NumVersions")
-				return
-			}
-		case "numObjects":
-			z.NumObjects, err = dc.ReadInt()
-			if err != nil {
-				err = msgp.WrapError(err, "NumObjects")
-				return
-			}
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z TierStats) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 3
-	// write "totalSize"
-	err = en.Append(0x83, 0xa9, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	if err != nil {
-		return
-	}
-	err = en.WriteUint64(z.TotalSize)
-	if err != nil {
-		err = msgp.WrapError(err, "TotalSize")
-		return
-	}
-	// write "numVersions"
-	err = en.Append(0xab, 0x6e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.NumVersions)
-	if err != nil {
-		err = msgp.WrapError(err, "NumVersions")
-		return
-	}
-	// write "numObjects"
-	err = en.Append(0xaa, 0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
-	if err != nil {
-		return
-	}
-	err = en.WriteInt(z.NumObjects)
-	if err != nil {
-		err = msgp.WrapError(err, "NumObjects")
-		return
-	}
-	return
-}
-
-// MarshalMsg implements msgp.Marshaler
-func (z TierStats) MarshalMsg(b []byte) (o []byte, err error) {
-	o = msgp.Require(b, z.Msgsize())
-	// map header, size 3
-	// string "totalSize"
-	o = append(o, 0x83, 0xa9, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
-	o = msgp.AppendUint64(o, z.TotalSize)
-	// string "numVersions"
-	o = append(o, 0xab, 0x6e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
-	o = msgp.AppendInt(o, z.NumVersions)
-	// string "numObjects"
-	o = append(o, 0xaa, 0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
-	o = msgp.AppendInt(o, z.NumObjects)
-	return
-}
-
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *TierStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	for zb0001 > 0 {
-		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "totalSize":
-			z.TotalSize, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "TotalSize")
-				return
-			}
-		case "numVersions":
-			z.NumVersions, bts, err = msgp.ReadIntBytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "NumVersions")
-				return
-			}
-		case "numObjects":
-			z.NumObjects, bts, err = msgp.ReadIntBytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "NumObjects")
-				return
-			}
-		default:
-			bts, err = msgp.Skip(bts)
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	o = bts
-	return
-}
-
-// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z TierStats) Msgsize() (s int) {
-	s = 1 + 10 + msgp.Uint64Size + 12 + msgp.IntSize + 11 + msgp.IntSize
-	return
-}
-
-// DecodeMsg implements msgp.Decodable
-func (z *Usage) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "size":
-			z.Size, err = dc.ReadUint64()
+			}
+		case "numVersions":
+			z.NumVersions, err = dc.ReadInt()
 			if err != nil {
-				err = msgp.WrapError(err, "Size")
+				err = msgp.WrapError(err, "NumVersions")
 				return
 			}
-		case "error":
-			z.Error, err = dc.ReadString()
+		case "numObjects":
+			z.NumObjects, err = dc.ReadInt()
 			if err != nil {
-				err = msgp.WrapError(err, "Error")
+				err = msgp.WrapError(err, "NumObjects")
 				return
 			}
-			zb0001Mask |= 0x1
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -15075,88 +14856,63 @@ func (z *Usage) DecodeMsg(dc *msgp.Reader) (err error) {
 			}
 		}
 	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
 	return
 }
 
 // EncodeMsg implements msgp.Encodable
-func (z Usage) EncodeMsg(en *msgp.Writer) (err error) {
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
+func (z TierStats) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "totalSize"
+	err = en.Append(0x83, 0xa9, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+	if err != nil {
+		return
 	}
-	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
+	err = en.WriteUint64(z.TotalSize)
 	if err != nil {
+		err = msgp.WrapError(err, "TotalSize")
 		return
 	}
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// write "size"
-		err = en.Append(0xa4, 0x73, 0x69, 0x7a, 0x65)
-		if err != nil {
-			return
-		}
-		err = en.WriteUint64(z.Size)
-		if err != nil {
-			err = msgp.WrapError(err, "Size")
-			return
-		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// write "error"
-			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			if err != nil {
-				return
-			}
-			err = en.WriteString(z.Error)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-		}
+	// write "numVersions"
+	err = en.Append(0xab, 0x6e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.NumVersions)
+	if err != nil {
+		err = msgp.WrapError(err, "NumVersions")
+		return
+	}
+	// write "numObjects"
+	err = en.Append(0xaa, 0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.NumObjects)
+	if err != nil {
+		err = msgp.WrapError(err, "NumObjects")
+		return
 	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
-func (z Usage) MarshalMsg(b []byte) (o []byte, err error) {
+func (z TierStats) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// string "size"
-		o = append(o, 0xa4, 0x73, 0x69, 0x7a, 0x65)
-		o = msgp.AppendUint64(o, z.Size)
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// string "error"
-			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			o = msgp.AppendString(o, z.Error)
-		}
-	}
+	// map header, size 3
+	// string "totalSize"
+	o = append(o, 0x83, 0xa9, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65)
+	o = msgp.AppendUint64(o, z.TotalSize)
+	// string "numVersions"
+	o = append(o, 0xab, 0x6e, 0x75, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73)
+	o = msgp.AppendInt(o, z.NumVersions)
+	// string "numObjects"
+	o = append(o, 0xaa, 0x6e, 0x75, 0x6d, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73)
+	o = msgp.AppendInt(o, z.NumObjects)
 	return
 }
 
 // UnmarshalMsg implements msgp.Unmarshaler
-func (z *Usage) UnmarshalMsg(bts []byte) (o []byte, err error) {
+func (z *TierStats) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
 	var zb0001 uint32
@@ -15165,8 +14921,6 @@ func (z *Usage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 		err = msgp.WrapError(err)
 		return
 	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
 	for zb0001 > 0 {
 		zb0001--
 		field, bts, err = msgp.ReadMapKeyZC(bts)
@@ -15175,19 +14929,24 @@ func (z *Usage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			return
 		}
 		switch msgp.UnsafeString(field) {
-		case "size":
-			z.Size, bts, err = msgp.ReadUint64Bytes(bts)
+		case "totalSize":
+			z.TotalSize, bts, err = msgp.ReadUint64Bytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Size")
+				err = msgp.WrapError(err, "TotalSize")
 				return
 			}
-		case "error":
-			z.Error, bts, err = msgp.ReadStringBytes(bts)
+		case "numVersions":
+			z.NumVersions, bts, err = msgp.ReadIntBytes(bts)
 			if err != nil {
-				err = msgp.WrapError(err, "Error")
+				err = msgp.WrapError(err, "NumVersions")
+				return
+			}
+		case "numObjects":
+			z.NumObjects, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "NumObjects")
 				return
 			}
-			zb0001Mask |= 0x1
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -15196,18 +14955,13 @@ func (z *Usage) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			}
 		}
 	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
 	o = bts
 	return
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z Usage) Msgsize() (s int) {
-	s = 1 + 5 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Error)
+func (z TierStats) Msgsize() (s int) {
+	s = 1 + 10 + msgp.Uint64Size + 12 + msgp.IntSize + 11 + msgp.IntSize
 	return
 }
 
@@ -15363,180 +15117,3 @@ func (z Version) Msgsize() (s int) {
 	s = 1 + 6 + msgp.Uint16Size + 6 + msgp.Uint16Size + 6 + msgp.Uint16Size
 	return
 }
-
-// DecodeMsg implements msgp.Decodable
-func (z *Versions) DecodeMsg(dc *msgp.Reader) (err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, err = dc.ReadMapHeader()
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, err = dc.ReadMapKeyPtr()
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, err = dc.ReadUint64()
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, err = dc.ReadString()
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			err = dc.Skip()
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	return
-}
-
-// EncodeMsg implements msgp.Encodable
-func (z Versions) EncodeMsg(en *msgp.Writer) (err error) {
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	err = en.Append(0x80 | uint8(zb0001Len))
-	if err != nil {
-		return
-	}
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// write "count"
-		err = en.Append(0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		if err != nil {
-			return
-		}
-		err = en.WriteUint64(z.Count)
-		if err != nil {
-			err = msgp.WrapError(err, "Count")
-			return
-		}
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// write "error"
-			err = en.Append(0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			if err != nil {
-				return
-			}
-			err = en.WriteString(z.Error)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-		}
-	}
-	return
-}
-
-// MarshalMsg implements msgp.Marshaler
-func (z Versions) MarshalMsg(b []byte) (o []byte, err error) {
-	o = msgp.Require(b, z.Msgsize())
-	// check for omitted fields
-	zb0001Len := uint32(2)
-	var zb0001Mask uint8 /* 2 bits */
-	_ = zb0001Mask
-	if z.Error == "" {
-		zb0001Len--
-		zb0001Mask |= 0x2
-	}
-	// variable map header, size zb0001Len
-	o = append(o, 0x80|uint8(zb0001Len))
-
-	// skip if no fields are to be emitted
-	if zb0001Len != 0 {
-		// string "count"
-		o = append(o, 0xa5, 0x63, 0x6f, 0x75, 0x6e, 0x74)
-		o = msgp.AppendUint64(o, z.Count)
-		if (zb0001Mask & 0x2) == 0 { // if not omitted
-			// string "error"
-			o = append(o, 0xa5, 0x65, 0x72, 0x72, 0x6f, 0x72)
-			o = msgp.AppendString(o, z.Error)
-		}
-	}
-	return
-}
-
-// UnmarshalMsg implements msgp.Unmarshaler
-func (z *Versions) UnmarshalMsg(bts []byte) (o []byte, err error) {
-	var field []byte
-	_ = field
-	var zb0001 uint32
-	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
-	if err != nil {
-		err = msgp.WrapError(err)
-		return
-	}
-	var zb0001Mask uint8 /* 1 bits */
-	_ = zb0001Mask
-	for zb0001 > 0 {
-		zb0001--
-		field, bts, err = msgp.ReadMapKeyZC(bts)
-		if err != nil {
-			err = msgp.WrapError(err)
-			return
-		}
-		switch msgp.UnsafeString(field) {
-		case "count":
-			z.Count, bts, err = msgp.ReadUint64Bytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Count")
-				return
-			}
-		case "error":
-			z.Error, bts, err = msgp.ReadStringBytes(bts)
-			if err != nil {
-				err = msgp.WrapError(err, "Error")
-				return
-			}
-			zb0001Mask |= 0x1
-		default:
-			bts, err = msgp.Skip(bts)
-			if err != nil {
-				err = msgp.WrapError(err)
-				return
-			}
-		}
-	}
-	// Clear omitted fields.
-	if (zb0001Mask & 0x1) == 0 {
-		z.Error = ""
-	}
-
-	o = bts
-	return
-}
-
-// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z Versions) Msgsize() (s int) {
-	s = 1 + 6 + msgp.Uint64Size + 6 + msgp.StringPrefixSize + len(z.Error)
-	return
-}
@@ -687,119 +687,6 @@ func BenchmarkDecodeBucketUsageInfo(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalBuckets(t *testing.T) {
-	v := Buckets{}
-	bts, err := v.MarshalMsg(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-	left, err := v.UnmarshalMsg(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
-	}
-
-	left, err = msgp.Skip(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
-	}
-}
-
-func BenchmarkMarshalMsgBuckets(b *testing.B) {
-	v := Buckets{}
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.MarshalMsg(nil)
-	}
-}
-
-func BenchmarkAppendMsgBuckets(b *testing.B) {
-	v := Buckets{}
-	bts := make([]byte, 0, v.Msgsize())
-	bts, _ = v.MarshalMsg(bts[0:0])
-	b.SetBytes(int64(len(bts)))
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		bts, _ = v.MarshalMsg(bts[0:0])
-	}
-}
-
-func BenchmarkUnmarshalBuckets(b *testing.B) {
-	v := Buckets{}
-	bts, _ := v.MarshalMsg(nil)
-	b.ReportAllocs()
-	b.SetBytes(int64(len(bts)))
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := v.UnmarshalMsg(bts)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
-func TestEncodeDecodeBuckets(t *testing.T) {
-	v := Buckets{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-
-	m := v.Msgsize()
-	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeBuckets Msgsize() is inaccurate")
-	}
-
-	vn := Buckets{}
-	err := msgp.Decode(&buf, &vn)
-	if err != nil {
-		t.Error(err)
-	}
-
-	buf.Reset()
-	msgp.Encode(&buf, &v)
-	err = msgp.NewReader(&buf).Skip()
-	if err != nil {
-		t.Error(err)
-	}
-}
-
-func BenchmarkEncodeBuckets(b *testing.B) {
-	v := Buckets{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	en := msgp.NewWriter(msgp.Nowhere)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.EncodeMsg(en)
-	}
-	en.Flush()
-}
-
-func BenchmarkDecodeBuckets(b *testing.B) {
-	v := Buckets{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	rd := msgp.NewEndlessReader(buf.Bytes(), b)
-	dc := msgp.NewReader(rd)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		err := v.DecodeMsg(dc)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
 func TestMarshalUnmarshalCPU(t *testing.T) {
 	v := CPU{}
 	bts, err := v.MarshalMsg(nil)
@@ -1139,119 +1026,6 @@ func BenchmarkDecodeDataUsageInfo(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalDeleteMarkers(t *testing.T) {
-	v := DeleteMarkers{}
-	bts, err := v.MarshalMsg(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-	left, err := v.UnmarshalMsg(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
-	}
-
-	left, err = msgp.Skip(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
-	}
-}
-
-func BenchmarkMarshalMsgDeleteMarkers(b *testing.B) {
-	v := DeleteMarkers{}
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.MarshalMsg(nil)
-	}
-}
-
-func BenchmarkAppendMsgDeleteMarkers(b *testing.B) {
-	v := DeleteMarkers{}
-	bts := make([]byte, 0, v.Msgsize())
-	bts, _ = v.MarshalMsg(bts[0:0])
-	b.SetBytes(int64(len(bts)))
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		bts, _ = v.MarshalMsg(bts[0:0])
-	}
-}
-
-func BenchmarkUnmarshalDeleteMarkers(b *testing.B) {
-	v := DeleteMarkers{}
-	bts, _ := v.MarshalMsg(nil)
-	b.ReportAllocs()
-	b.SetBytes(int64(len(bts)))
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := v.UnmarshalMsg(bts)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
-func TestEncodeDecodeDeleteMarkers(t *testing.T) {
-	v := DeleteMarkers{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-
-	m := v.Msgsize()
-	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeDeleteMarkers Msgsize() is inaccurate")
-	}
-
-	vn := DeleteMarkers{}
-	err := msgp.Decode(&buf, &vn)
-	if err != nil {
-		t.Error(err)
-	}
-
-	buf.Reset()
-	msgp.Encode(&buf, &v)
-	err = msgp.NewReader(&buf).Skip()
-	if err != nil {
-		t.Error(err)
-	}
-}
-
-func BenchmarkEncodeDeleteMarkers(b *testing.B) {
-	v := DeleteMarkers{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	en := msgp.NewWriter(msgp.Nowhere)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.EncodeMsg(en)
-	}
-	en.Flush()
-}
-
-func BenchmarkDecodeDeleteMarkers(b *testing.B) {
-	v := DeleteMarkers{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	rd := msgp.NewEndlessReader(buf.Bytes(), b)
-	dc := msgp.NewReader(rd)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		err := v.DecodeMsg(dc)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
 func TestMarshalUnmarshalDisk(t *testing.T) {
 	v := Disk{}
 	bts, err := v.MarshalMsg(nil)
@@ -2156,8 +1930,8 @@ func BenchmarkDecodeInfoMessage(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalKMS(t *testing.T) {
-	v := KMS{}
+func TestMarshalUnmarshalInfoSnapshot(t *testing.T) {
+	v := InfoSnapshot{}
 	bts, err := v.MarshalMsg(nil)
 	if err != nil {
 		t.Fatal(err)
@@ -2179,8 +1953,8 @@ func TestMarshalUnmarshalKMS(t *testing.T) {
 	}
 }
 
-func BenchmarkMarshalMsgKMS(b *testing.B) {
-	v := KMS{}
+func BenchmarkMarshalMsgInfoSnapshot(b *testing.B) {
+	v := InfoSnapshot{}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -2188,8 +1962,8 @@ func BenchmarkMarshalMsgKMS(b *testing.B) {
 	}
 }
 
-func BenchmarkAppendMsgKMS(b *testing.B) {
-	v := KMS{}
+func BenchmarkAppendMsgInfoSnapshot(b *testing.B) {
+	v := InfoSnapshot{}
 	bts := make([]byte, 0, v.Msgsize())
 	bts, _ = v.MarshalMsg(bts[0:0])
 	b.SetBytes(int64(len(bts)))
@@ -2200,8 +1974,8 @@ func BenchmarkAppendMsgKMS(b *testing.B) {
 	}
 }
 
-func BenchmarkUnmarshalKMS(b *testing.B) {
-	v := KMS{}
+func BenchmarkUnmarshalInfoSnapshot(b *testing.B) {
+	v := InfoSnapshot{}
 	bts, _ := v.MarshalMsg(nil)
 	b.ReportAllocs()
 	b.SetBytes(int64(len(bts)))
@@ -2214,17 +1988,17 @@ func BenchmarkUnmarshalKMS(b *testing.B) {
 	}
 }
 
-func TestEncodeDecodeKMS(t *testing.T) {
-	v := KMS{}
+func TestEncodeDecodeInfoSnapshot(t *testing.T) {
+	v := InfoSnapshot{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeKMS Msgsize() is inaccurate")
+		t.Log("WARNING: TestEncodeDecodeInfoSnapshot Msgsize() is inaccurate")
 	}
 
-	vn := KMS{}
+	vn := InfoSnapshot{}
 	err := msgp.Decode(&buf, &vn)
 	if err != nil {
 		t.Error(err)
@@ -2238,8 +2012,8 @@ func TestEncodeDecodeKMS(t *testing.T) {
 	}
 }
 
-func BenchmarkEncodeKMS(b *testing.B) {
-	v := KMS{}
+func BenchmarkEncodeInfoSnapshot(b *testing.B) {
+	v := InfoSnapshot{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2252,8 +2026,8 @@ func BenchmarkEncodeKMS(b *testing.B) {
 	en.Flush()
 }
 
-func BenchmarkDecodeKMS(b *testing.B) {
-	v := KMS{}
+func BenchmarkDecodeInfoSnapshot(b *testing.B) {
+	v := InfoSnapshot{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2269,8 +2043,8 @@ func BenchmarkDecodeKMS(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalLDAP(t *testing.T) {
-	v := LDAP{}
+func TestMarshalUnmarshalKMS(t *testing.T) {
+	v := KMS{}
 	bts, err := v.MarshalMsg(nil)
 	if err != nil {
 		t.Fatal(err)
@@ -2292,8 +2066,8 @@ func TestMarshalUnmarshalLDAP(t *testing.T) {
 	}
 }
 
-func BenchmarkMarshalMsgLDAP(b *testing.B) {
-	v := LDAP{}
+func BenchmarkMarshalMsgKMS(b *testing.B) {
+	v := KMS{}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -2301,8 +2075,8 @@ func BenchmarkMarshalMsgLDAP(b *testing.B) {
 	}
 }
 
-func BenchmarkAppendMsgLDAP(b *testing.B) {
-	v := LDAP{}
+func BenchmarkAppendMsgKMS(b *testing.B) {
+	v := KMS{}
 	bts := make([]byte, 0, v.Msgsize())
 	bts, _ = v.MarshalMsg(bts[0:0])
 	b.SetBytes(int64(len(bts)))
@@ -2313,8 +2087,8 @@ func BenchmarkAppendMsgLDAP(b *testing.B) {
 	}
 }
 
-func BenchmarkUnmarshalLDAP(b *testing.B) {
-	v := LDAP{}
+func BenchmarkUnmarshalKMS(b *testing.B) {
+	v := KMS{}
 	bts, _ := v.MarshalMsg(nil)
 	b.ReportAllocs()
 	b.SetBytes(int64(len(bts)))
@@ -2327,17 +2101,17 @@ func BenchmarkUnmarshalLDAP(b *testing.B) {
 	}
 }
 
-func TestEncodeDecodeLDAP(t *testing.T) {
-	v := LDAP{}
+func TestEncodeDecodeKMS(t *testing.T) {
+	v := KMS{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeLDAP Msgsize() is inaccurate")
+		t.Log("WARNING: TestEncodeDecodeKMS Msgsize() is inaccurate")
 	}
 
-	vn := LDAP{}
+	vn := KMS{}
 	err := msgp.Decode(&buf, &vn)
 	if err != nil {
 		t.Error(err)
@@ -2351,8 +2125,8 @@ func TestEncodeDecodeLDAP(t *testing.T) {
 	}
 }
 
-func BenchmarkEncodeLDAP(b *testing.B) {
-	v := LDAP{}
+func BenchmarkEncodeKMS(b *testing.B) {
+	v := KMS{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2365,8 +2139,8 @@ func BenchmarkEncodeLDAP(b *testing.B) {
 	en.Flush()
 }
 
-func BenchmarkDecodeLDAP(b *testing.B) {
-	v := LDAP{}
+func BenchmarkDecodeKMS(b *testing.B) {
+	v := KMS{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2382,8 +2156,8 @@ func BenchmarkDecodeLDAP(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalLogger(t *testing.T) {
-	v := Logger{}
+func TestMarshalUnmarshalLDAP(t *testing.T) {
+	v := LDAP{}
 	bts, err := v.MarshalMsg(nil)
 	if err != nil {
 		t.Fatal(err)
@@ -2405,8 +2179,8 @@ func TestMarshalUnmarshalLogger(t *testing.T) {
 	}
 }
 
-func BenchmarkMarshalMsgLogger(b *testing.B) {
-	v := Logger{}
+func BenchmarkMarshalMsgLDAP(b *testing.B) {
+	v := LDAP{}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -2414,8 +2188,8 @@ func BenchmarkMarshalMsgLogger(b *testing.B) {
 	}
 }
 
-func BenchmarkAppendMsgLogger(b *testing.B) {
-	v := Logger{}
+func BenchmarkAppendMsgLDAP(b *testing.B) {
+	v := LDAP{}
 	bts := make([]byte, 0, v.Msgsize())
 	bts, _ = v.MarshalMsg(bts[0:0])
 	b.SetBytes(int64(len(bts)))
@@ -2426,8 +2200,8 @@ func BenchmarkAppendMsgLogger(b *testing.B) {
 	}
 }
 
-func BenchmarkUnmarshalLogger(b *testing.B) {
-	v := Logger{}
+func BenchmarkUnmarshalLDAP(b *testing.B) {
+	v := LDAP{}
 	bts, _ := v.MarshalMsg(nil)
 	b.ReportAllocs()
 	b.SetBytes(int64(len(bts)))
@@ -2440,17 +2214,17 @@ func BenchmarkUnmarshalLogger(b *testing.B) {
 	}
 }
 
-func TestEncodeDecodeLogger(t *testing.T) {
-	v := Logger{}
+func TestEncodeDecodeLDAP(t *testing.T) {
+	v := LDAP{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeLogger Msgsize() is inaccurate")
+		t.Log("WARNING: TestEncodeDecodeLDAP Msgsize() is inaccurate")
 	}
 
-	vn := Logger{}
+	vn := LDAP{}
 	err := msgp.Decode(&buf, &vn)
 	if err != nil {
 		t.Error(err)
@@ -2464,8 +2238,8 @@ func TestEncodeDecodeLogger(t *testing.T) {
 	}
 }
 
-func BenchmarkEncodeLogger(b *testing.B) {
-	v := Logger{}
+func BenchmarkEncodeLDAP(b *testing.B) {
+	v := LDAP{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2478,8 +2252,8 @@ func BenchmarkEncodeLogger(b *testing.B) {
 	en.Flush()
 }
 
-func BenchmarkDecodeLogger(b *testing.B) {
-	v := Logger{}
+func BenchmarkDecodeLDAP(b *testing.B) {
+	v := LDAP{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2495,8 +2269,8 @@ func BenchmarkDecodeLogger(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalMemStats(t *testing.T) {
-	v := MemStats{}
+func TestMarshalUnmarshalLogger(t *testing.T) {
+	v := Logger{}
 	bts, err := v.MarshalMsg(nil)
 	if err != nil {
 		t.Fatal(err)
@@ -2518,8 +2292,8 @@ func TestMarshalUnmarshalMemStats(t *testing.T) {
 	}
 }
 
-func BenchmarkMarshalMsgMemStats(b *testing.B) {
-	v := MemStats{}
+func BenchmarkMarshalMsgLogger(b *testing.B) {
+	v := Logger{}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -2527,8 +2301,8 @@ func BenchmarkMarshalMsgMemStats(b *testing.B) {
 	}
 }
 
-func BenchmarkAppendMsgMemStats(b *testing.B) {
-	v := MemStats{}
+func BenchmarkAppendMsgLogger(b *testing.B) {
+	v := Logger{}
 	bts := make([]byte, 0, v.Msgsize())
 	bts, _ = v.MarshalMsg(bts[0:0])
 	b.SetBytes(int64(len(bts)))
@@ -2539,8 +2313,8 @@ func BenchmarkAppendMsgMemStats(b *testing.B) {
 	}
 }
 
-func BenchmarkUnmarshalMemStats(b *testing.B) {
-	v := MemStats{}
+func BenchmarkUnmarshalLogger(b *testing.B) {
+	v := Logger{}
 	bts, _ := v.MarshalMsg(nil)
 	b.ReportAllocs()
 	b.SetBytes(int64(len(bts)))
@@ -2553,17 +2327,17 @@ func BenchmarkUnmarshalMemStats(b *testing.B) {
 	}
 }
 
-func TestEncodeDecodeMemStats(t *testing.T) {
-	v := MemStats{}
+func TestEncodeDecodeLogger(t *testing.T) {
+	v := Logger{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeMemStats Msgsize() is inaccurate")
+		t.Log("WARNING: TestEncodeDecodeLogger Msgsize() is inaccurate")
 	}
 
-	vn := MemStats{}
+	vn := Logger{}
 	err := msgp.Decode(&buf, &vn)
 	if err != nil {
 		t.Error(err)
@@ -2577,8 +2351,8 @@ func TestEncodeDecodeMemStats(t *testing.T) {
 	}
 }
 
-func BenchmarkEncodeMemStats(b *testing.B) {
-	v := MemStats{}
+func BenchmarkEncodeLogger(b *testing.B) {
+	v := Logger{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2591,8 +2365,8 @@ func BenchmarkEncodeMemStats(b *testing.B) {
 	en.Flush()
 }
 
-func BenchmarkDecodeMemStats(b *testing.B) {
-	v := MemStats{}
+func BenchmarkDecodeLogger(b *testing.B) {
+	v := Logger{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2608,8 +2382,8 @@ func BenchmarkDecodeMemStats(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalObjects(t *testing.T) {
-	v := Objects{}
+func TestMarshalUnmarshalMemStats(t *testing.T) {
+	v := MemStats{}
 	bts, err := v.MarshalMsg(nil)
 	if err != nil {
 		t.Fatal(err)
@@ -2631,8 +2405,8 @@ func TestMarshalUnmarshalObjects(t *testing.T) {
 	}
 }
 
-func BenchmarkMarshalMsgObjects(b *testing.B) {
-	v := Objects{}
+func BenchmarkMarshalMsgMemStats(b *testing.B) {
+	v := MemStats{}
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -2640,8 +2414,8 @@ func BenchmarkMarshalMsgObjects(b *testing.B) {
 	}
 }
 
-func BenchmarkAppendMsgObjects(b *testing.B) {
-	v := Objects{}
+func BenchmarkAppendMsgMemStats(b *testing.B) {
+	v := MemStats{}
 	bts := make([]byte, 0, v.Msgsize())
 	bts, _ = v.MarshalMsg(bts[0:0])
 	b.SetBytes(int64(len(bts)))
@@ -2652,8 +2426,8 @@ func BenchmarkAppendMsgObjects(b *testing.B) {
 	}
 }
 
-func BenchmarkUnmarshalObjects(b *testing.B) {
-	v := Objects{}
+func BenchmarkUnmarshalMemStats(b *testing.B) {
+	v := MemStats{}
 	bts, _ := v.MarshalMsg(nil)
 	b.ReportAllocs()
 	b.SetBytes(int64(len(bts)))
@@ -2666,17 +2440,17 @@ func BenchmarkUnmarshalObjects(b *testing.B) {
 	}
 }
 
-func TestEncodeDecodeObjects(t *testing.T) {
-	v := Objects{}
+func TestEncodeDecodeMemStats(t *testing.T) {
+	v := MemStats{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 
 	m := v.Msgsize()
 	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeObjects Msgsize() is inaccurate")
+		t.Log("WARNING: TestEncodeDecodeMemStats Msgsize() is inaccurate")
 	}
 
-	vn := Objects{}
+	vn := MemStats{}
 	err := msgp.Decode(&buf, &vn)
 	if err != nil {
 		t.Error(err)
@@ -2690,8 +2464,8 @@ func TestEncodeDecodeObjects(t *testing.T) {
 	}
 }
 
-func BenchmarkEncodeObjects(b *testing.B) {
-	v := Objects{}
+func BenchmarkEncodeMemStats(b *testing.B) {
+	v := MemStats{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -2704,8 +2478,8 @@ func BenchmarkEncodeObjects(b *testing.B) {
 	en.Flush()
 }
 
-func BenchmarkDecodeObjects(b *testing.B) {
-	v := Objects{}
+func BenchmarkDecodeMemStats(b *testing.B) {
+	v := MemStats{}
 	var buf bytes.Buffer
 	msgp.Encode(&buf, &v)
 	b.SetBytes(int64(buf.Len()))
@@ -3512,119 +3286,6 @@ func BenchmarkDecodeTierStats(b *testing.B) {
 	}
 }
 
-func TestMarshalUnmarshalUsage(t *testing.T) {
-	v := Usage{}
-	bts, err := v.MarshalMsg(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-	left, err := v.UnmarshalMsg(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
-	}
-
-	left, err = msgp.Skip(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
-	}
-}
-
-func BenchmarkMarshalMsgUsage(b *testing.B) {
-	v := Usage{}
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.MarshalMsg(nil)
-	}
-}
-
-func BenchmarkAppendMsgUsage(b *testing.B) {
-	v := Usage{}
-	bts := make([]byte, 0, v.Msgsize())
-	bts, _ = v.MarshalMsg(bts[0:0])
-	b.SetBytes(int64(len(bts)))
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		bts, _ = v.MarshalMsg(bts[0:0])
-	}
-}
-
-func BenchmarkUnmarshalUsage(b *testing.B) {
-	v := Usage{}
-	bts, _ := v.MarshalMsg(nil)
-	b.ReportAllocs()
-	b.SetBytes(int64(len(bts)))
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := v.UnmarshalMsg(bts)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
-func TestEncodeDecodeUsage(t *testing.T) {
-	v := Usage{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-
-	m := v.Msgsize()
-	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeUsage Msgsize() is inaccurate")
-	}
-
-	vn := Usage{}
-	err := msgp.Decode(&buf, &vn)
-	if err != nil {
-		t.Error(err)
-	}
-
-	buf.Reset()
-	msgp.Encode(&buf, &v)
-	err = msgp.NewReader(&buf).Skip()
-	if err != nil {
-		t.Error(err)
-	}
-}
-
-func BenchmarkEncodeUsage(b *testing.B) {
-	v := Usage{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	en := msgp.NewWriter(msgp.Nowhere)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.EncodeMsg(en)
-	}
-	en.Flush()
-}
-
-func BenchmarkDecodeUsage(b *testing.B) {
-	v := Usage{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	rd := msgp.NewEndlessReader(buf.Bytes(), b)
-	dc := msgp.NewReader(rd)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		err := v.DecodeMsg(dc)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
 func TestMarshalUnmarshalVersion(t *testing.T) {
 	v := Version{}
 	bts, err := v.MarshalMsg(nil)
@@ -3737,116 +3398,3 @@ func BenchmarkDecodeVersion(b *testing.B) {
 		}
 	}
 }
-
-func TestMarshalUnmarshalVersions(t *testing.T) {
-	v := Versions{}
-	bts, err := v.MarshalMsg(nil)
-	if err != nil {
-		t.Fatal(err)
-	}
-	left, err := v.UnmarshalMsg(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
-	}
-
-	left, err = msgp.Skip(bts)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if len(left) > 0 {
-		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
-	}
-}
-
-func BenchmarkMarshalMsgVersions(b *testing.B) {
-	v := Versions{}
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.MarshalMsg(nil)
-	}
-}
-
-func BenchmarkAppendMsgVersions(b *testing.B) {
-	v := Versions{}
-	bts := make([]byte, 0, v.Msgsize())
-	bts, _ = v.MarshalMsg(bts[0:0])
-	b.SetBytes(int64(len(bts)))
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		bts, _ = v.MarshalMsg(bts[0:0])
-	}
-}
-
-func BenchmarkUnmarshalVersions(b *testing.B) {
-	v := Versions{}
-	bts, _ := v.MarshalMsg(nil)
-	b.ReportAllocs()
-	b.SetBytes(int64(len(bts)))
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := v.UnmarshalMsg(bts)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
-
-func TestEncodeDecodeVersions(t *testing.T) {
-	v := Versions{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-
-	m := v.Msgsize()
-	if buf.Len() > m {
-		t.Log("WARNING: TestEncodeDecodeVersions Msgsize() is inaccurate")
-	}
-
-	vn := Versions{}
-	err := msgp.Decode(&buf, &vn)
-	if err != nil {
-		t.Error(err)
-	}
-
-	buf.Reset()
-	msgp.Encode(&buf, &v)
-	err = msgp.NewReader(&buf).Skip()
-	if err != nil {
-		t.Error(err)
-	}
-}
-
-func BenchmarkEncodeVersions(b *testing.B) {
-	v := Versions{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	en := msgp.NewWriter(msgp.Nowhere)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		v.EncodeMsg(en)
-	}
-	en.Flush()
-}
-
-func BenchmarkDecodeVersions(b *testing.B) {
-	v := Versions{}
-	var buf bytes.Buffer
-	msgp.Encode(&buf, &v)
-	b.SetBytes(int64(buf.Len()))
-	rd := msgp.NewEndlessReader(buf.Bytes(), b)
-	dc := msgp.NewReader(rd)
-	b.ReportAllocs()
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		err := v.DecodeMsg(dc)
-		if err != nil {
-			b.Fatal(err)
-		}
-	}
-}
@@ -0,0 +1,214 @@
+//
+// Copyright (c) 2015-2024 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import "github.com/tinylib/msgp/msgp"
+
+//msgp:tag json
+//go:generate msgp -d clearomitted -d "timezone utc" -unexported -file $GOFILE
+
+// The count types of InfoMessage are serialized through countMsg and
+// usageMsg, so that Reported is set when decoding a value, as with JSON.
+// InfoMessage omits the counts that were neither reported nor set.
+
+// countMsg is the msgp encoding of Buckets, Objects, Versions and
+// DeleteMarkers.
+type countMsg struct {
+	Count    uint64 `json:"count"`
+	Error    string `json:"error,omitempty"`
+	Reported bool   `json:"-"`
+}
+
+// usageMsg is the msgp encoding of Usage.
+type usageMsg struct {
+	Size     uint64 `json:"size"`
+	Error    string `json:"error,omitempty"`
+	Reported bool   `json:"-"`
+}
+
+// DecodeMsg implements msgp.Decodable
+func (v *Buckets) DecodeMsg(dc *msgp.Reader) error {
+	if err := (*countMsg)(v).DecodeMsg(dc); err != nil {
+		return err
+	}
+	v.Reported = true
+	return nil
+}
+
+// EncodeMsg implements msgp.Encodable
+func (v Buckets) EncodeMsg(en *msgp.Writer) error {
+	return countMsg(v).EncodeMsg(en)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (v Buckets) MarshalMsg(b []byte) ([]byte, error) {
+	return countMsg(v).MarshalMsg(b)
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (v *Buckets) UnmarshalMsg(bts []byte) ([]byte, error) {
+	o, err := (*countMsg)(v).UnmarshalMsg(bts)
+	if err != nil {
+		return o, err
+	}
+	v.Reported = true
+	return o, nil
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (v Buckets) Msgsize() int {
+	return countMsg(v).Msgsize()
+}
+
+// DecodeMsg implements msgp.Decodable
+func (v *Objects) DecodeMsg(dc *msgp.Reader) error {
+	if err := (*countMsg)(v).DecodeMsg(dc); err != nil {
+		return err
+	}
+	v.Reported = true
+	return nil
+}
+
+// EncodeMsg implements msgp.Encodable
+func (v Objects) EncodeMsg(en *msgp.Writer) error {
+	return countMsg(v).EncodeMsg(en)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (v Objects) MarshalMsg(b []byte) ([]byte, error) {
+	return countMsg(v).MarshalMsg(b)
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (v *Objects) UnmarshalMsg(bts []byte) ([]byte, error) {
+	o, err := (*countMsg)(v).UnmarshalMsg(bts)
+	if err != nil {
+		return o, err
+	}
+	v.Reported = true
+	return o, nil
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (v Objects) Msgsize() int {
+	return countMsg(v).Msgsize()
+}
+
+// DecodeMsg implements msgp.Decodable
+func (v *Versions) DecodeMsg(dc *msgp.Reader) error {
+	if err := (*countMsg)(v).DecodeMsg(dc); err != nil {
+		return err
+	}
+	v.Reported = true
+	return nil
+}
+
+// EncodeMsg implements msgp.Encodable
+func (v Versions) EncodeMsg(en *msgp.Writer) error {
+	return countMsg(v).EncodeMsg(en)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (v Versions) MarshalMsg(b []byte) ([]byte, error) {
+	return countMsg(v).MarshalMsg(b)
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (v *Versions) UnmarshalMsg(bts []byte) ([]byte, error) {
+	o, err := (*countMsg)(v).UnmarshalMsg(bts)
+	if err != nil {
+		return o, err
+	}
+	v.Reported = true
+	return o, nil
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (v Versions) Msgsize() int {
+	return countMsg(v).Msgsize()
+}
+
+// DecodeMsg implements msgp.Decodable
+func (v *DeleteMarkers) DecodeMsg(dc *msgp.Reader) error {
+	if err := (*countMsg)(v).DecodeMsg(dc); err != nil {
+		return err
+	}
+	v.Reported = true
+	return nil
+}
+
+// EncodeMsg implements msgp.Encodable
+func (v DeleteMarkers) EncodeMsg(en *msgp.Writer) error {
+	return countMsg(v).EncodeMsg(en)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (v DeleteMarkers) MarshalMsg(b []byte) ([]byte, error) {
+	return countMsg(v).MarshalMsg(b)
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (v *DeleteMarkers) UnmarshalMsg(bts []byte) ([]byte, error) {
+	o, err := (*countMsg)(v).UnmarshalMsg(bts)
+	if err != nil {
+		return o, err
+	}
+	v.Reported = true
+	return o, nil
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (v DeleteMarkers) Msgsize() int {
+	return countMsg(v).Msgsize()
+}
+
+// DecodeMsg implements msgp.Decodable
+func (v *Usage) DecodeMsg(dc *msgp.Reader) error {
+	if err := (*usageMsg)(v).DecodeMsg(dc); err != nil {
+		return err
+	}
+	v.Reported = true
+	return nil
+}
+
+// EncodeMsg implements msgp.Encodable
+func (v Usage) EncodeMsg(en *msgp.Writer) error {
+	return usageMsg(v).EncodeMsg(en)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (v Usage) MarshalMsg(b []byte) ([]byte, error) {
+	return usageMsg(v).MarshalMsg(b)
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (v *Usage) UnmarshalMsg(bts []byte) ([]byte, error) {
+	o, err := (*usageMsg)(v).UnmarshalMsg(bts)
+	if err != nil {
+		return o, err
+	}
+	v.Reported = true
+	return o, nil
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (v Usage) Msgsize() int {
+	return usageMsg(v).Msgsize()
+}
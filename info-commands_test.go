@@ -19,11 +19,52 @@
 package madmin
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
 	"sort"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/tinylib/msgp/msgp"
 )
 
+// newInfoTestClient returns an AdminClient talking to a test server
+// serving the given handler.
+func newInfoTestClient(t *testing.T, handler http.Handler) *AdminClient {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clnt, err := NewWithOptions(u.Host, &Options{
+		Creds: credentials.NewStaticV4("minioadmin", "minioadmin", ""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clnt
+}
+
 // TestListNotificationARNs tests the ListNotificationARNs method of the Services struct.
 func TestListNotificationARNs(t *testing.T) {
 	tests := []struct {
@@ -246,3 +287,3505 @@ func compareARNs(a, b ARN) (bool, string) {
 	}
 	return true, ""
 }
+
+func TestParitySafetyMargin(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  BackendInfo
+		expected float64
+	}{
+		{
+			name:     "FS backend",
+			backend:  BackendInfo{Type: FS},
+			expected: 0,
+		},
+		{
+			name: "EC:4 on 16 drives",
+			backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCParities: []int{4},
+				DrivesPerSet:       []int{16},
+			},
+			expected: 0.25,
+		},
+		{
+			name: "EC:2 on 4 drives",
+			backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCParities: []int{2},
+				DrivesPerSet:       []int{4},
+			},
+			expected: 0.25,
+		},
+		{
+			name: "Most constrained pool wins",
+			backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCParities: []int{4, 2},
+				DrivesPerSet:       []int{8, 16},
+			},
+			expected: 0.125,
+		},
+		{
+			name: "Zero parity",
+			backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCParities: []int{0},
+				DrivesPerSet:       []int{1},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si := StorageInfo{Backend: tt.backend}
+			if got := si.ParitySafetyMargin(); got != tt.expected {
+				t.Errorf("ParitySafetyMargin() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMostErroringServer(t *testing.T) {
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{
+				Endpoint: "node1:9000",
+				Disks: []Disk{
+					{Metrics: &DiskStatus{TotalErrorsAvailability: 1}},
+					{Metrics: &DiskStatus{TotalErrorsTimeout: 2}},
+				},
+			},
+			{
+				Endpoint: "node2:9000",
+				Disks: []Disk{
+					{Metrics: &DiskStatus{TotalErrorsAvailability: 40, TotalErrorsTimeout: 2}},
+					{},
+				},
+			},
+			{
+				Endpoint: "node3:9000",
+				Disks:    []Disk{{}},
+			},
+		},
+	}
+	endpoint, errs, ok := info.MostErroringServer()
+	if !ok || endpoint != "node2:9000" || errs != 42 {
+		t.Errorf("MostErroringServer() = (%q, %d, %v), want (%q, 42, true)", endpoint, errs, ok, "node2:9000")
+	}
+
+	if _, _, ok = (InfoMessage{Servers: []ServerProperties{{Disks: []Disk{{}}}}}).MostErroringServer(); ok {
+		t.Error("MostErroringServer() ok = true without metrics")
+	}
+}
+
+func TestInfoSnapshot(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+	}))
+
+	info, err := clnt.ServerInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.FetchedAt().IsZero() {
+		t.Error("FetchedAt() is zero after ServerInfo")
+	}
+	if info.Source() != clnt.endpointURL.String() {
+		t.Errorf("Source() = %q, want %q", info.Source(), clnt.endpointURL.String())
+	}
+
+	buf, err := json.Marshal(info.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var snapshot InfoSnapshot
+	if err = json.Unmarshal(buf, &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	restored := snapshot.Message()
+	if !restored.FetchedAt().Equal(info.FetchedAt()) {
+		t.Errorf("FetchedAt() = %v, want %v", restored.FetchedAt(), info.FetchedAt())
+	}
+	if restored.Source() != info.Source() {
+		t.Errorf("Source() = %q, want %q", restored.Source(), info.Source())
+	}
+	if restored.DeploymentID != "deployment" {
+		t.Errorf("DeploymentID = %q, want %q", restored.DeploymentID, "deployment")
+	}
+}
+
+func TestObjectsByStorageClass(t *testing.T) {
+	du := DataUsageInfo{
+		BucketsUsage: map[string]BucketUsageInfo{
+			"bucket1": {ObjectsCountByStorageClass: map[string]uint64{"STANDARD": 10, "REDUCED_REDUNDANCY": 2}},
+			"bucket2": {ObjectsCountByStorageClass: map[string]uint64{"STANDARD": 5}},
+			"bucket3": {ObjectsCount: 7},
+		},
+	}
+	got := du.ObjectsByStorageClass()
+	if len(got) != 2 || got["STANDARD"] != 15 || got["REDUCED_REDUNDANCY"] != 2 {
+		t.Errorf("ObjectsByStorageClass() = %v, want STANDARD:15 REDUCED_REDUNDANCY:2", got)
+	}
+
+	got = DataUsageInfo{BucketsUsage: map[string]BucketUsageInfo{"bucket": {ObjectsCount: 1}}}.ObjectsByStorageClass()
+	if got == nil || len(got) != 0 {
+		t.Errorf("ObjectsByStorageClass() = %v, want empty map", got)
+	}
+}
+
+func TestValidDeploymentID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		valid      bool
+		normalized string
+	}{
+		{
+			name:       "Valid UUID",
+			id:         "7a3c5e2f-9b1d-4c8e-a6f0-2d4b8e1c3a5f",
+			valid:      true,
+			normalized: "7a3c5e2f-9b1d-4c8e-a6f0-2d4b8e1c3a5f",
+		},
+		{
+			name:       "Uppercase UUID",
+			id:         "7A3C5E2F-9B1D-4C8E-A6F0-2D4B8E1C3A5F",
+			valid:      true,
+			normalized: "7a3c5e2f-9b1d-4c8e-a6f0-2d4b8e1c3a5f",
+		},
+		{
+			name: "Empty",
+			id:   "",
+		},
+		{
+			name: "Malformed",
+			id:   "7a3c5e2f-9b1d-4c8e-a6f0",
+		},
+		{
+			name: "Not hex",
+			id:   "zzzzzzzz-9b1d-4c8e-a6f0-2d4b8e1c3a5f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := InfoMessage{DeploymentID: tt.id}
+			if got := info.ValidDeploymentID(); got != tt.valid {
+				t.Errorf("ValidDeploymentID() = %v, want %v", got, tt.valid)
+			}
+			if got := info.NormalizedDeploymentID(); got != tt.normalized {
+				t.Errorf("NormalizedDeploymentID() = %q, want %q", got, tt.normalized)
+			}
+		})
+	}
+}
+
+func TestClusterCache(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{Cache: &CacheStats{Capacity: 100, Used: 10, Hits: 30, Misses: 10}},
+			{Cache: &CacheStats{Capacity: 200, Used: 50, Hits: 50, Misses: 10}},
+			{},
+		},
+	}
+	if got := si.ClusterCacheHitRatio(); got != 0.8 {
+		t.Errorf("ClusterCacheHitRatio() = %v, want 0.8", got)
+	}
+	if capacity, used := si.ClusterCacheUsage(); capacity != 300 || used != 60 {
+		t.Errorf("ClusterCacheUsage() = (%d, %d), want (300, 60)", capacity, used)
+	}
+
+	noCache := StorageInfo{Disks: []Disk{{}, {}}}
+	if got := noCache.ClusterCacheHitRatio(); got != 0 {
+		t.Errorf("ClusterCacheHitRatio() = %v, want 0", got)
+	}
+}
+
+func TestPoolsByFill(t *testing.T) {
+	info := InfoMessage{
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {
+				0: {RawUsage: 10, RawCapacity: 100},
+				1: {RawUsage: 10, RawCapacity: 100},
+			},
+			1: {
+				0: {RawUsage: 90, RawCapacity: 100},
+			},
+			2: {
+				0: {},
+			},
+			3: {
+				0: {RawUsage: 50, RawCapacity: 100},
+			},
+		},
+	}
+	got := info.PoolsByFill()
+	wantOrder := []int{1, 3, 0, 2}
+	if len(got) != len(wantOrder) {
+		t.Fatalf("PoolsByFill() returned %d pools, want %d", len(got), len(wantOrder))
+	}
+	for i, pool := range wantOrder {
+		if got[i].Pool != pool {
+			t.Errorf("PoolsByFill()[%d].Pool = %d, want %d", i, got[i].Pool, pool)
+		}
+	}
+	if got[2].Used != 20 || got[2].Capacity != 200 || got[2].Ratio != 0.1 {
+		t.Errorf("PoolsByFill()[2] = %+v, want used 20, capacity 200, ratio 0.1", got[2])
+	}
+}
+
+func TestDriveCountConsistent(t *testing.T) {
+	tests := []struct {
+		name       string
+		backend    ErasureBackend
+		ok         bool
+		configured int
+		reported   int
+	}{
+		{
+			name: "Matching",
+			backend: ErasureBackend{
+				OnlineDisks:  30,
+				OfflineDisks: 2,
+				TotalSets:    []int{2, 1},
+				DrivesPerSet: []int{8, 16},
+			},
+			ok:         true,
+			configured: 32,
+			reported:   32,
+		},
+		{
+			name: "Mismatched",
+			backend: ErasureBackend{
+				OnlineDisks:  12,
+				TotalSets:    []int{1},
+				DrivesPerSet: []int{16},
+			},
+			configured: 16,
+			reported:   12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, configured, reported := tt.backend.DriveCountConsistent()
+			if ok != tt.ok || configured != tt.configured || reported != tt.reported {
+				t.Errorf("DriveCountConsistent() = (%v, %d, %d), want (%v, %d, %d)", ok, configured, reported, tt.ok, tt.configured, tt.reported)
+			}
+		})
+	}
+}
+
+func TestLatestGC(t *testing.T) {
+	now := time.Now()
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{Endpoint: "node1:9000", GCStats: &GCStats{LastGC: now.Add(-time.Minute)}},
+			{Endpoint: "node2:9000", GCStats: &GCStats{LastGC: now}},
+			{Endpoint: "node3:9000"},
+			{Endpoint: "node4:9000", GCStats: &GCStats{LastGC: now.Add(-time.Hour)}},
+		},
+	}
+	latest, endpoint := info.LatestGC()
+	if !latest.Equal(now) || endpoint != "node2:9000" {
+		t.Errorf("LatestGC() = (%v, %q), want (%v, %q)", latest, endpoint, now, "node2:9000")
+	}
+
+	latest, endpoint = InfoMessage{Servers: []ServerProperties{{Endpoint: "node1:9000"}}}.LatestGC()
+	if !latest.IsZero() || endpoint != "" {
+		t.Errorf("LatestGC() = (%v, %q), want zero values", latest, endpoint)
+	}
+}
+
+func TestReplicationProgress(t *testing.T) {
+	du := DataUsageInfo{
+		BucketsUsage: map[string]BucketUsageInfo{
+			"full":    {ReplicatedSize: 100},
+			"partial": {ReplicatedSize: 50, ReplicationPendingSize: 30, ReplicationFailedSize: 20},
+			"none":    {Size: 100},
+		},
+	}
+	want := map[string]float64{"full": 1, "partial": 0.5, "none": 1}
+	for bucket, progress := range want {
+		if got := du.BucketsUsage[bucket].ReplicationProgress(); got != progress {
+			t.Errorf("%s: ReplicationProgress() = %v, want %v", bucket, got, progress)
+		}
+	}
+
+	if got := du.LaggingBuckets(0.9); len(got) != 1 || got[0] != "partial" {
+		t.Errorf("LaggingBuckets(0.9) = %v, want [partial]", got)
+	}
+	if got := du.LaggingBuckets(0.5); len(got) != 0 {
+		t.Errorf("LaggingBuckets(0.5) = %v, want none", got)
+	}
+}
+
+func TestValidateInfoMessageJSON(t *testing.T) {
+	valid := []byte(`{"mode":"online","deploymentID":"id","objects":{"count":10},"servers":[{"endpoint":"node1:9000","drives":[{"state":"ok"}]}],"pools":{"0":{"0":{"id":0}}}}`)
+	if problems := ValidateInfoMessageJSON(valid); len(problems) != 0 {
+		t.Errorf("ValidateInfoMessageJSON() = %v, want no problems", problems)
+	}
+
+	invalid := []byte(`{"mode":"online","newField":true,"objects":{"count":"ten"},"servers":[{"endpoint":"node1:9000","drives":[{"state":"ok","extra":1}]}]}`)
+	want := []string{
+		`unknown field "newField"`,
+		`field "objects.count": cannot use JSON string as uint64`,
+		`unknown field "servers[0].drives[0].extra"`,
+	}
+	got := ValidateInfoMessageJSON(invalid)
+	if len(got) != len(want) {
+		t.Fatalf("ValidateInfoMessageJSON() = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ValidateInfoMessageJSON()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if got := ValidateInfoMessageJSON([]byte(`not json`)); len(got) != 1 {
+		t.Errorf("ValidateInfoMessageJSON() = %q, want one problem", got)
+	}
+
+	nested := []byte(`{"objects":{"count":1,"extra":1}}`)
+	if got := ValidateInfoMessageJSON(nested); len(got) != 1 || got[0] != `unknown field "objects.extra"` {
+		t.Errorf("ValidateInfoMessageJSON() = %q, want unknown field \"objects.extra\"", got)
+	}
+
+	// Accepted by DiskStatus.UnmarshalJSON, unknown fields are still reported.
+	tokens := []byte(`{"servers":[{"drives":[{"metrics":{"totalTokens":"12","apiCalls":{"ReadAll":1}}},{"metrics":{"totalTokens":"12","extra":1}}]}]}`)
+	got = ValidateInfoMessageJSON(tokens)
+	if len(got) != 1 || got[0] != `unknown field "servers[0].drives[1].metrics.extra"` {
+		t.Errorf("ValidateInfoMessageJSON() = %q, want unknown field \"servers[0].drives[1].metrics.extra\"", got)
+	}
+	var info InfoMessage
+	if err := decodeInfoMessageStrict([]byte(`{"servers":[{"drives":[{"metrics":{"totalTokens":"12"}}]}]}`), &info); err != nil {
+		t.Fatalf("decodeInfoMessageStrict() = %v, want no error", err)
+	}
+	if got := info.Servers[0].Disks[0].Metrics.TotalTokens; got != 12 {
+		t.Errorf("TotalTokens = %d, want 12", got)
+	}
+}
+
+func TestRawCapacityFromPools(t *testing.T) {
+	info := InfoMessage{
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {
+				0: {RawUsage: 10, RawCapacity: 100},
+				1: {RawUsage: 20, RawCapacity: 100},
+			},
+			1: {
+				0: {RawUsage: 30, RawCapacity: 200},
+			},
+		},
+	}
+	if capacity, usage := info.RawCapacityFromPools(); capacity != 400 || usage != 60 {
+		t.Errorf("RawCapacityFromPools() = (%d, %d), want (400, 60)", capacity, usage)
+	}
+	if capacity, usage := (InfoMessage{}).RawCapacityFromPools(); capacity != 0 || usage != 0 {
+		t.Errorf("RawCapacityFromPools() = (%d, %d), want (0, 0)", capacity, usage)
+	}
+}
+
+func TestSipHash24(t *testing.T) {
+	// Reference vectors from the SipHash paper, key 00..0f.
+	k0, k1 := uint64(0x0706050403020100), uint64(0x0f0e0d0c0b0a0908)
+	msg := make([]byte, 15)
+	for i := range msg {
+		msg[i] = byte(i)
+	}
+	if got := sipHash24(k0, k1, nil); got != 0x726fdb47dd0e0e31 {
+		t.Errorf("sipHash24(empty) = %#x, want %#x", got, uint64(0x726fdb47dd0e0e31))
+	}
+	if got := sipHash24(k0, k1, msg); got != 0xa129ca6149be45e5 {
+		t.Errorf("sipHash24(00..0e) = %#x, want %#x", got, uint64(0xa129ca6149be45e5))
+	}
+}
+
+func TestPlacementHint(t *testing.T) {
+	info := InfoMessage{
+		DeploymentID: "00010203-0405-0607-0809-0a0b0c0d0e0f",
+		Backend: ErasureBackend{
+			TotalSets:    []int{2},
+			DrivesPerSet: []int{4},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {0: {}, 1: {}},
+		},
+	}
+	for set := range 2 {
+		srv := ServerProperties{Endpoint: fmt.Sprintf("node%d:9000", set)}
+		for disk := range 4 {
+			srv.Disks = append(srv.Disks, Disk{
+				Endpoint:  fmt.Sprintf("http://node%d:9000/data%d", set, disk),
+				PoolIndex: 0,
+				SetIndex:  set,
+				DiskIndex: disk,
+			})
+		}
+		info.Servers = append(info.Servers, srv)
+	}
+
+	// MinIO places "bucket/object" of this deployment on set 1, with the
+	// distribution [4 1 2 3]: shard 1 on the second disk of the set and so on.
+	pool, set, disks, ok := info.PlacementHint("bucket", "object")
+	if !ok {
+		t.Fatal("PlacementHint() ok = false")
+	}
+	if pool != 0 || set != 1 {
+		t.Errorf("PlacementHint() = (%d, %d), want (0, 1)", pool, set)
+	}
+	wantDisks := []string{
+		"http://node1:9000/data1",
+		"http://node1:9000/data2",
+		"http://node1:9000/data3",
+		"http://node1:9000/data0",
+	}
+	if !slices.Equal(disks, wantDisks) {
+		t.Errorf("PlacementHint() disks = %v, want %v", disks, wantDisks)
+	}
+
+	info.DeploymentID = ""
+	if _, _, _, ok = info.PlacementHint("bucket", "object"); ok {
+		t.Error("PlacementHint() ok = true without deployment ID")
+	}
+}
+
+func TestMinDrivesForAvailability(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  ErasureBackend
+		expected int
+	}{
+		{
+			name: "Single pool",
+			backend: ErasureBackend{
+				StandardSCParity: 4,
+				TotalSets:        []int{2},
+				DrivesPerSet:     []int{16},
+			},
+			expected: 24,
+		},
+		{
+			name: "Multiple pools",
+			backend: ErasureBackend{
+				StandardSCParity: 2,
+				TotalSets:        []int{1, 4},
+				DrivesPerSet:     []int{4, 8},
+			},
+			expected: 26,
+		},
+		{
+			name:     "No layout",
+			backend:  ErasureBackend{},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := InfoMessage{Backend: tt.backend}
+			if got := info.MinDrivesForAvailability(); got != tt.expected {
+				t.Errorf("MinDrivesForAvailability() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFilterDisksAndServers(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{Endpoint: "disk1", State: "ok", Healing: true},
+			{Endpoint: "disk2", State: "offline"},
+			{Endpoint: "disk3", State: "ok"},
+		},
+	}
+	if got := si.FilterDisks(func(d Disk) bool { return d.State == "ok" }); len(got) != 2 || got[0].Endpoint != "disk1" || got[1].Endpoint != "disk3" {
+		t.Errorf("FilterDisks(online) = %v, want disk1 and disk3", got)
+	}
+	if got := si.FilterDisks(func(d Disk) bool { return d.Healing }); len(got) != 1 || got[0].Endpoint != "disk1" {
+		t.Errorf("FilterDisks(healing) = %v, want disk1", got)
+	}
+
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{Endpoint: "node1:9000", State: "online", IsLeader: true},
+			{Endpoint: "node2:9000", State: "offline"},
+		},
+	}
+	if got := info.FilterServers(func(s ServerProperties) bool { return s.State != "online" }); len(got) != 1 || got[0].Endpoint != "node2:9000" {
+		t.Errorf("FilterServers(offline) = %v, want node2:9000", got)
+	}
+	if got := info.FilterServers(func(ServerProperties) bool { return false }); len(got) != 0 {
+		t.Errorf("FilterServers(none) = %v, want none", got)
+	}
+}
+
+func TestUnplacedOnlineDisks(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{Endpoint: "placed", State: DriveStateOk, PoolIndex: 0, SetIndex: 1, DiskIndex: 2},
+			{Endpoint: "unplaced", State: DriveStateOk, PoolIndex: -1, SetIndex: -1, DiskIndex: -1},
+			{Endpoint: "partial", State: DriveStateOk, PoolIndex: 0, SetIndex: -1, DiskIndex: -1},
+			{Endpoint: "offline", State: DriveStateOffline, PoolIndex: -1, SetIndex: -1, DiskIndex: -1},
+		},
+	}
+	got := si.UnplacedOnlineDisks()
+	if len(got) != 2 || got[0].Endpoint != "unplaced" || got[1].Endpoint != "partial" {
+		t.Errorf("UnplacedOnlineDisks() = %v, want unplaced and partial", got)
+	}
+}
+
+func TestPoolSummary(t *testing.T) {
+	info := InfoMessage{
+		Backend: ErasureBackend{
+			TotalSets:    []int{2, 1, 1},
+			DrivesPerSet: []int{4, 4, 8},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {0: {RawUsage: 10, RawCapacity: 100}, 1: {RawUsage: 20, RawCapacity: 100}},
+			1: {0: {RawUsage: 50, RawCapacity: 100}},
+			2: {0: {RawCapacity: 100}},
+		},
+		Servers: []ServerProperties{
+			{Endpoint: "node1:9000", State: string(ItemOnline), PoolNumber: 0, PoolNumbers: []int{0}},
+			{Endpoint: "node2:9000", State: string(ItemDraining), PoolNumber: 1, PoolNumbers: []int{1}},
+			{Endpoint: "node3:9000", State: string(ItemDraining), PoolNumber: 2, PoolNumbers: []int{2}},
+		},
+	}
+	want := []PoolState{
+		{Pool: 0, Sets: 2, Drives: 8, Used: 30, Capacity: 200, Phase: PoolPhaseActive},
+		{Pool: 1, Sets: 1, Drives: 4, Used: 50, Capacity: 100, Phase: PoolPhaseDraining},
+		{Pool: 2, Sets: 1, Drives: 8, Used: 0, Capacity: 100, Phase: PoolPhaseEmpty},
+	}
+	got := info.PoolSummary()
+	if len(got) != len(want) {
+		t.Fatalf("PoolSummary() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("PoolSummary()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSinglePointsOfFailure(t *testing.T) {
+	// newCluster returns a single set of 4 drives spread over the given
+	// number of nodes.
+	newCluster := func(nodes, parity int) InfoMessage {
+		info := InfoMessage{
+			Backend: ErasureBackend{
+				StandardSCParity: parity,
+				TotalSets:        []int{1},
+				DrivesPerSet:     []int{4},
+			},
+		}
+		for n := range nodes {
+			info.Servers = append(info.Servers, ServerProperties{Endpoint: fmt.Sprintf("node%d:9000", n)})
+		}
+		for d := range 4 {
+			srv := &info.Servers[d%nodes]
+			srv.Disks = append(srv.Disks, Disk{State: DriveStateOk, SetIndex: 0, DiskIndex: d})
+		}
+		return info
+	}
+
+	// Two nodes with two drives each, a single parity drive per set.
+	fragile := newCluster(2, 1).SinglePointsOfFailure()
+	if len(fragile) != 2 {
+		t.Fatalf("SinglePointsOfFailure() = %+v, want both nodes", fragile)
+	}
+	want := SPOF{Node: "node0:9000", Pool: 0, Set: 0, Remaining: 2, ReadQuorum: 3}
+	if fragile[0] != want {
+		t.Errorf("SinglePointsOfFailure()[0] = %+v, want %+v", fragile[0], want)
+	}
+
+	// Four nodes with one drive each and two parity drives.
+	if got := newCluster(4, 2).SinglePointsOfFailure(); len(got) != 0 {
+		t.Errorf("SinglePointsOfFailure() = %+v, want none", got)
+	}
+}
+
+func TestDataVsMetadataDrives(t *testing.T) {
+	si := StorageInfo{Disks: []Disk{{}, {}, {Metadata: true}, {}}}
+	if data, metadata := si.DataVsMetadataDrives(); data != 3 || metadata != 1 {
+		t.Errorf("DataVsMetadataDrives() = (%d, %d), want (3, 1)", data, metadata)
+	}
+	si = StorageInfo{Disks: []Disk{{}, {}}}
+	if data, metadata := si.DataVsMetadataDrives(); data != 2 || metadata != 0 {
+		t.Errorf("DataVsMetadataDrives() = (%d, %d), want (2, 0)", data, metadata)
+	}
+}
+
+func TestPoolFaultTolerance(t *testing.T) {
+	info := InfoMessage{
+		Backend: ErasureBackend{
+			StandardSCParity: 3,
+			TotalSets:        []int{2, 2},
+			DrivesPerSet:     []int{8, 8},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {
+				0: {OnlineDisks: 8},
+				1: {OnlineDisks: 7, OfflineDisks: 1},
+			},
+			1: {
+				0: {OnlineDisks: 6, OfflineDisks: 2},
+				1: {OnlineDisks: 4, OfflineDisks: 4},
+			},
+		},
+	}
+	got := info.PoolFaultTolerance()
+	if len(got) != 2 || got[0] != 2 || got[1] != -1 {
+		t.Errorf("PoolFaultTolerance() = %v, want map[0:2 1:-1]", got)
+	}
+}
+
+func TestDiscoverEndpoints(t *testing.T) {
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{Endpoint: "node2:9000", Scheme: "https", State: string(ItemOnline)},
+			{Endpoint: "node1:9000", Scheme: "https", State: string(ItemOffline)},
+			{Endpoint: "node2:9000", Scheme: "https", State: string(ItemOnline)},
+			{Endpoint: "node3:9000", State: string(ItemOnline)},
+		},
+	}
+	got, err := info.DiscoverEndpoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"http://node3:9000", "https://node1:9000", "https://node2:9000"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("DiscoverEndpoints() = %v, want %v", got, want)
+	}
+
+	states := info.DiscoverEndpointsWithState()
+	if len(states) != 3 || states[1].Online || !states[2].Online {
+		t.Errorf("DiscoverEndpointsWithState() = %+v, want node1 offline and node2 online", states)
+	}
+
+	if _, err = (InfoMessage{}).DiscoverEndpoints(); err == nil {
+		t.Error("DiscoverEndpoints() returned no error without servers")
+	}
+}
+
+func TestAvgObjectsPerDrive(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{State: DriveStateOk},
+			{State: DriveStateOk},
+			{State: DriveStateOk},
+			{State: DriveStateOk},
+			{State: DriveStateOk, Metadata: true},
+			{State: DriveStateOffline},
+		},
+	}
+	im := InfoMessage{Objects: Objects{Count: 1000}}
+	if got := si.AvgObjectsPerDrive(im); got != 250 {
+		t.Errorf("AvgObjectsPerDrive() = %v, want 250", got)
+	}
+	if got := (StorageInfo{}).AvgObjectsPerDrive(im); got != 0 {
+		t.Errorf("AvgObjectsPerDrive() = %v, want 0", got)
+	}
+}
+
+func TestDriveStateDistribution(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{State: DriveStateOk},
+			{State: DriveStateOk, Healing: true},
+			{State: DriveStateOk, Scanning: true},
+			{State: DriveStateOffline},
+			{State: DriveStateOffline},
+			{State: DriveStateUnformatted},
+			{State: DriveStateFaulty},
+			{State: DriveStateOk, Healing: true, Scanning: true},
+		},
+	}
+	want := map[string]float64{
+		DriveStateOk:          0.5,
+		DriveStateOffline:     0.25,
+		DriveStateUnformatted: 0.125,
+		DriveStateFaulty:      0.125,
+	}
+	got, healing, scanning := si.DriveStateDistribution()
+	if len(got) != len(want) {
+		t.Fatalf("DriveStateDistribution() = %v, want %v", got, want)
+	}
+	var sum float64
+	for state, fraction := range want {
+		if got[state] != fraction {
+			t.Errorf("DriveStateDistribution()[%s] = %v, want %v", state, got[state], fraction)
+		}
+		sum += got[state]
+	}
+	if sum != 1 {
+		t.Errorf("DriveStateDistribution() sums to %v, want 1", sum)
+	}
+	if healing != 0.25 || scanning != 0.25 {
+		t.Errorf("DriveStateDistribution() healing, scanning = %v, %v, want 0.25, 0.25", healing, scanning)
+	}
+
+	// Fractions of drive counts not divisible by 2 still sum to 1.
+	si = StorageInfo{Disks: []Disk{{State: DriveStateOk}, {State: DriveStateOk}, {State: DriveStateOffline}}}
+	got, _, _ = si.DriveStateDistribution()
+	if sum := got[DriveStateOk] + got[DriveStateOffline]; sum != 1 {
+		t.Errorf("DriveStateDistribution() = %v sums to %v, want 1", got, sum)
+	}
+}
+
+func TestReconcileCapacity(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{TotalSpace: 500},
+			{TotalSpace: 500},
+			{TotalSpace: 100, RootDisk: true},
+		},
+	}
+	tests := []struct {
+		name   string
+		du     DataUsageInfo
+		within bool
+	}{
+		{name: "Matching", du: DataUsageInfo{TotalCapacity: 1000}, within: true},
+		{name: "Within tolerance", du: DataUsageInfo{TotalCapacity: 995}, within: true},
+		{name: "Divergent", du: DataUsageInfo{TotalCapacity: 2000}},
+		{name: "Missing", du: DataUsageInfo{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			siTotal, duTotal, within := ReconcileCapacity(si, tt.du)
+			if siTotal != 1000 || duTotal != tt.du.TotalCapacity || within != tt.within {
+				t.Errorf("ReconcileCapacity() = (%d, %d, %v), want (1000, %d, %v)", siTotal, duTotal, within, tt.du.TotalCapacity, tt.within)
+			}
+		})
+	}
+
+	t.Run("Erasure", func(t *testing.T) {
+		// Two pools of 4 disks of 1000 bytes, EC:2 and EC:1.
+		var si StorageInfo
+		si.Backend.Type = Erasure
+		si.Backend.StandardSCData = []int{2, 3}
+		si.Backend.StandardSCParities = []int{2, 1}
+		for pool := range 2 {
+			for range 4 {
+				si.Disks = append(si.Disks, Disk{PoolIndex: pool, TotalSpace: 1000})
+			}
+		}
+		siTotal, duTotal, within := ReconcileCapacity(si, DataUsageInfo{TotalCapacity: 5000})
+		if siTotal != 5000 || duTotal != 5000 || !within {
+			t.Errorf("ReconcileCapacity() = (%d, %d, %v), want (5000, 5000, true)", siTotal, duTotal, within)
+		}
+		if _, _, within = ReconcileCapacity(si, DataUsageInfo{TotalCapacity: 8000}); within {
+			t.Error("ReconcileCapacity() within tolerance of the raw capacity")
+		}
+	})
+}
+
+func TestLeaderElectionState(t *testing.T) {
+	tests := []struct {
+		name    string
+		leaders []bool
+		want    LeaderState
+	}{
+		{name: "Single leader", leaders: []bool{true, false, false}, want: LeaderSingle},
+		{name: "No leader", leaders: []bool{false, false}, want: LeaderNone},
+		{name: "Split brain", leaders: []bool{true, false, true}, want: LeaderMultiple},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var info InfoMessage
+			for _, leader := range tt.leaders {
+				info.Servers = append(info.Servers, ServerProperties{IsLeader: leader})
+			}
+			if got := info.LeaderElectionState(); got != tt.want {
+				t.Errorf("LeaderElectionState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTierTransitionRates(t *testing.T) {
+	prev := DataUsageInfo{
+		TierStats: map[string]TierStats{
+			"WARM":    {TotalSize: 1000},
+			"COLD":    {TotalSize: 5000},
+			"ARCHIVE": {TotalSize: 100},
+		},
+	}
+	cur := DataUsageInfo{
+		TierStats: map[string]TierStats{
+			"WARM":    {TotalSize: 3000},
+			"COLD":    {TotalSize: 4000},
+			"ARCHIVE": {TotalSize: 100},
+			"NEW":     {TotalSize: 500},
+		},
+	}
+	got := TierTransitionRates(prev, cur, 10*time.Second)
+	want := map[string]float64{"WARM": 200, "COLD": 0, "ARCHIVE": 0, "NEW": 50}
+	if len(got) != len(want) {
+		t.Fatalf("TierTransitionRates() = %v, want %v", got, want)
+	}
+	for tier, rate := range want {
+		if got[tier] != rate {
+			t.Errorf("TierTransitionRates()[%s] = %v, want %v", tier, got[tier], rate)
+		}
+	}
+	if got := TierTransitionRates(prev, cur, 0); len(got) != 0 {
+		t.Errorf("TierTransitionRates() = %v, want empty map", got)
+	}
+}
+
+func TestWriteAmplification(t *testing.T) {
+	tests := []struct {
+		name     string
+		si       StorageInfo
+		expected float64
+	}{
+		{
+			name:     "FS backend",
+			si:       StorageInfo{Backend: BackendInfo{Type: FS}},
+			expected: 1,
+		},
+		{
+			name: "EC:4 on 16 drives",
+			si: StorageInfo{Backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCData:     []int{12},
+				StandardSCParities: []int{4},
+				DrivesPerSet:       []int{16},
+			}},
+			expected: 16.0 / 12,
+		},
+		{
+			name: "EC:2 on 4 drives from parity",
+			si: StorageInfo{Backend: BackendInfo{
+				Type:               Erasure,
+				StandardSCParities: []int{2},
+				DrivesPerSet:       []int{4},
+			}},
+			expected: 2,
+		},
+		{
+			name: "Capacity weighted pools",
+			si: StorageInfo{
+				Disks: []Disk{
+					{PoolIndex: 0, TotalSpace: 100},
+					{PoolIndex: 1, TotalSpace: 300},
+				},
+				Backend: BackendInfo{
+					Type:           Erasure,
+					StandardSCData: []int{2, 6},
+					DrivesPerSet:   []int{4, 8},
+				},
+			},
+			expected: (100*2.0 + 300*8.0/6) / 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.si.WriteAmplification(); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("WriteAmplification() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEmptyBuckets(t *testing.T) {
+	du := DataUsageInfo{
+		BucketsUsage: map[string]BucketUsageInfo{
+			"empty2":         {},
+			"empty1":         {},
+			"data":           {Size: 10, ObjectsCount: 1, VersionsCount: 1},
+			"versions":       {VersionsCount: 2},
+			"delete-markers": {DeleteMarkersCount: 3},
+		},
+	}
+	got := du.EmptyBuckets()
+	if len(got) != 2 || got[0] != "empty1" || got[1] != "empty2" {
+		t.Errorf("EmptyBuckets() = %v, want [empty1 empty2]", got)
+	}
+}
+
+func TestServerInfoPoolFilter(t *testing.T) {
+	var query url.Values
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		json.NewEncoder(w).Encode(InfoMessage{
+			Pools: map[int]map[int]ErasureSetInfo{
+				0: {0: {ID: 0}, 1: {ID: 1}},
+				1: {0: {ID: 0}, 1: {ID: 1}},
+			},
+		})
+	}))
+
+	info, err := clnt.ServerInfo(t.Context(), WithPoolFilter(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("pool") != "1" || query.Has("set") {
+		t.Errorf("ServerInfo() sent query %v, want pool=1", query)
+	}
+	if len(info.Pools) != 1 || len(info.Pools[1]) != 2 {
+		t.Errorf("ServerInfo() Pools = %v, want pool 1 with 2 sets", info.Pools)
+	}
+
+	info, err = clnt.ServerInfo(t.Context(), WithSetFilter(0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query.Get("pool") != "0" || query.Get("set") != "1" {
+		t.Errorf("ServerInfo() sent query %v, want pool=0 and set=1", query)
+	}
+	if len(info.Pools) != 1 || len(info.Pools[0]) != 1 || info.Pools[0][1].ID != 1 {
+		t.Errorf("ServerInfo() Pools = %v, want pool 0 set 1", info.Pools)
+	}
+
+	var notFound PoolNotFoundError
+	if _, err = clnt.ServerInfo(t.Context(), WithPoolFilter(5)); !errors.As(err, &notFound) || notFound.Pool != 5 || notFound.Set != -1 {
+		t.Errorf("ServerInfo() error = %v, want PoolNotFoundError for pool 5", err)
+	}
+	if _, err = clnt.ServerInfo(t.Context(), WithSetFilter(1, 7)); !errors.As(err, &notFound) || notFound.Set != 7 {
+		t.Errorf("ServerInfo() error = %v, want PoolNotFoundError for set 7", err)
+	}
+}
+
+func TestServerCapacityShares(t *testing.T) {
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{Endpoint: "node1:9000", State: string(ItemOnline), Disks: []Disk{{TotalSpace: 100}, {TotalSpace: 100}}},
+			{Endpoint: "node2:9000", State: string(ItemOnline), Disks: []Disk{{TotalSpace: 600}}},
+			{Endpoint: "node3:9000", State: string(ItemOnline), Disks: []Disk{{TotalSpace: 200}}},
+			{Endpoint: "node4:9000", State: string(ItemOffline), Disks: []Disk{{TotalSpace: 500}}},
+		},
+	}
+	want := map[string]float64{"node1:9000": 0.2, "node2:9000": 0.6, "node3:9000": 0.2, "node4:9000": 0}
+	got := info.ServerCapacityShares()
+	if len(got) != len(want) {
+		t.Fatalf("ServerCapacityShares() = %v, want %v", got, want)
+	}
+	for endpoint, share := range want {
+		if got[endpoint] != share {
+			t.Errorf("ServerCapacityShares()[%s] = %v, want %v", endpoint, got[endpoint], share)
+		}
+	}
+
+	empty := InfoMessage{Servers: []ServerProperties{{Endpoint: "node1:9000", State: string(ItemOnline)}}}
+	if got := empty.ServerCapacityShares(); got["node1:9000"] != 0 {
+		t.Errorf("ServerCapacityShares() = %v, want zero share", got)
+	}
+}
+
+func TestDriveSummary(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{State: DriveStateOk, TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60},
+			{State: DriveStateOk, Healing: true, TotalSpace: 100, UsedSpace: 10, AvailableSpace: 90},
+			{State: DriveStateOk, Scanning: true, RootDisk: true, TotalSpace: 50, UsedSpace: 50},
+			{State: DriveStateOffline},
+			{State: DriveStateFaulty, Healing: true},
+			{},
+		},
+	}
+	want := DriveSummary{
+		Online:         3,
+		Offline:        2,
+		Unknown:        1,
+		Healing:        2,
+		Scanning:       1,
+		Root:           1,
+		TotalSpace:     200,
+		UsedSpace:      50,
+		AvailableSpace: 150,
+	}
+	if got := si.DriveSummary(); got != want {
+		t.Errorf("DriveSummary() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFullSnapshot(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/info"):
+			json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+		case strings.HasSuffix(r.URL.Path, "/datausageinfo"):
+			json.NewEncoder(w).Encode(DataUsageInfo{BucketsCount: 3})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	snapshot, err := clnt.FullSnapshot(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.ServerInfoErr != nil || snapshot.ServerInfo.DeploymentID != "deployment" {
+		t.Errorf("FullSnapshot() ServerInfo = %+v, %v", snapshot.ServerInfo, snapshot.ServerInfoErr)
+	}
+	if snapshot.DataUsageInfoErr != nil || snapshot.DataUsageInfo.BucketsCount != 3 {
+		t.Errorf("FullSnapshot() DataUsageInfo = %+v, %v", snapshot.DataUsageInfo, snapshot.DataUsageInfoErr)
+	}
+	if snapshot.StorageInfoErr == nil {
+		t.Error("FullSnapshot() StorageInfoErr = nil, want error")
+	}
+
+	failing := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	if _, err = failing.FullSnapshot(t.Context()); err == nil {
+		t.Error("FullSnapshot() returned no error when all calls failed")
+	}
+}
+
+func TestStandardParityErr(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    InfoMessage
+		want    int
+		wantErr error
+	}{
+		{"erasure", InfoMessage{Backend: ErasureBackend{Type: "Erasure", StandardSCParity: 4}}, 4, nil},
+		{"fs", InfoMessage{Backend: ErasureBackend{Type: "FS"}}, 0, ErrNotErasureBackend},
+		{"unknown", InfoMessage{}, 0, ErrNotErasureBackend},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.info.StandardParityErr()
+			if got != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Errorf("StandardParityErr() = %d, %v, want %d, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiffBuckets(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := DataUsageInfo{
+		LastUpdate: start,
+		BucketsUsage: map[string]BucketUsageInfo{
+			"grown":   {Size: 100, ObjectsCount: 10, VersionsCount: 10},
+			"removed": {Size: 50, ObjectsCount: 5, VersionsCount: 6, DeleteMarkersCount: 1},
+		},
+	}
+	cur := DataUsageInfo{
+		LastUpdate: start.Add(time.Hour),
+		BucketsUsage: map[string]BucketUsageInfo{
+			"grown": {Size: 300, ObjectsCount: 15, VersionsCount: 20, DeleteMarkersCount: 2},
+			"added": {Size: 10, ObjectsCount: 1, VersionsCount: 1},
+		},
+	}
+
+	want := map[string]BucketUsageDelta{
+		"grown":   {Size: 200, ObjectsCount: 5, VersionsCount: 10, DeleteMarkersCount: 2, Elapsed: time.Hour},
+		"added":   {Size: 10, ObjectsCount: 1, VersionsCount: 1, Elapsed: time.Hour},
+		"removed": {Size: -50, ObjectsCount: -5, VersionsCount: -6, DeleteMarkersCount: -1, Elapsed: time.Hour},
+	}
+	got := cur.DiffBuckets(prev)
+	if len(got) != len(want) {
+		t.Fatalf("DiffBuckets() returned %d buckets, want %d", len(got), len(want))
+	}
+	for bucket, w := range want {
+		if got[bucket] != w {
+			t.Errorf("DiffBuckets()[%q] = %+v, want %+v", bucket, got[bucket], w)
+		}
+	}
+}
+
+func TestSurvivesPoolLoss(t *testing.T) {
+	pools := func(usage ...[2]uint64) map[int]map[int]ErasureSetInfo {
+		m := make(map[int]map[int]ErasureSetInfo)
+		for i, u := range usage {
+			m[i] = map[int]ErasureSetInfo{0: {ID: 0, RawUsage: u[0], RawCapacity: u[1]}}
+		}
+		return m
+	}
+	tests := []struct {
+		name  string
+		pools map[int]map[int]ErasureSetInfo
+		pool  int
+		want  bool
+	}{
+		{"absorbed", pools([2]uint64{40, 100}, [2]uint64{20, 100}, [2]uint64{20, 100}), 0, true},
+		{"exact fit", pools([2]uint64{80, 100}, [2]uint64{20, 100}), 0, true},
+		{"not absorbed", pools([2]uint64{90, 100}, [2]uint64{50, 100}), 0, false},
+		{"single pool", pools([2]uint64{10, 100}), 0, false},
+		{"unknown pool", pools([2]uint64{10, 100}, [2]uint64{10, 100}), 5, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := InfoMessage{Pools: tt.pools}
+			if got := info.SurvivesPoolLoss(tt.pool); got != tt.want {
+				t.Errorf("SurvivesPoolLoss(%d) = %v, want %v", tt.pool, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsableFreeWithReservation(t *testing.T) {
+	si := StorageInfo{
+		Disks: []Disk{
+			{PoolIndex: 0, AvailableSpace: 1000},
+			{PoolIndex: 0, AvailableSpace: 1000},
+			{PoolIndex: 1, AvailableSpace: 1000},
+			{PoolIndex: 0, AvailableSpace: 5000, RootDisk: true},
+		},
+	}
+	si.Backend.Type = Erasure
+	si.Backend.StandardSCData = []int{3, 2}
+	si.Backend.StandardSCParities = []int{1, 2}
+
+	// Pool 0 keeps 3/4 of 2000, pool 1 keeps 2/4 of 1000: 2000 usable.
+	tests := []struct {
+		name    string
+		reserve float64
+		want    uint64
+	}{
+		{"no reserve", 0, 2000},
+		{"default reserve", DefaultFreeSpaceReservePct, 1980},
+		{"ten percent", 10, 1800},
+		{"negative", -5, 2000},
+		{"everything", 150, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := si.UsableFreeWithReservation(tt.reserve); got != tt.want {
+				t.Errorf("UsableFreeWithReservation(%v) = %d, want %d", tt.reserve, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataUsageInfoTimeout(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		json.NewEncoder(w).Encode(DataUsageInfo{})
+	}))
+
+	_, err := clnt.DataUsageInfo(t.Context(), WithUsageTimeout(50*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("DataUsageInfo() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestWriteDisksCSV(t *testing.T) {
+	si := StorageInfo{Disks: []Disk{
+		{
+			Endpoint: "http://node1:9000/disk,1", State: DriveStateOk,
+			TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60,
+			ReadThroughput: 1.5, WriteThroughPut: 2, ReadLatency: 0.25, WriteLatency: 3, Utilization: 12.5,
+			Metrics:   &DiskStatus{},
+			PoolIndex: 0, SetIndex: 1, DiskIndex: 2,
+		},
+		{
+			Endpoint: "http://node2:9000/disk2", State: DriveStateOffline,
+			TotalSpace: 100,
+			PoolIndex:  1, SetIndex: 0, DiskIndex: 3,
+		},
+	}}
+
+	var buf strings.Builder
+	if err := si.WriteDisksCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "endpoint,state,total_space,used_space,available_space,read_throughput,write_throughput,read_latency,write_latency,utilization,pool_index,set_index,disk_index\n" +
+		"\"http://node1:9000/disk,1\",ok,100,40,60,1.5,2,0.25,3,12.5,0,1,2\n" +
+		"http://node2:9000/disk2,offline,100,0,0,,,,,,1,0,3\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteDisksCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSaturatedDisks(t *testing.T) {
+	disk := func(endpoint string, util float64, metrics bool) Disk {
+		d := Disk{Endpoint: endpoint, Utilization: util}
+		if metrics {
+			d.Metrics = &DiskStatus{}
+		}
+		return d
+	}
+	tests := []struct {
+		name          string
+		disks         []Disk
+		wantSaturated []string
+		wantCluster   bool
+	}{
+		{
+			name:          "idle",
+			disks:         []Disk{disk("d1", 10, true), disk("d2", 20, true)},
+			wantSaturated: nil,
+			wantCluster:   false,
+		},
+		{
+			name:          "one of four saturated",
+			disks:         []Disk{disk("d1", 100, true), disk("d2", 20, true), disk("d3", 5, true), disk("d4", 50, true)},
+			wantSaturated: []string{"d1"},
+			wantCluster:   true,
+		},
+		{
+			name:          "one of five saturated",
+			disks:         []Disk{disk("d1", 99.5, true), disk("d2", 20, true), disk("d3", 5, true), disk("d4", 50, true), disk("d5", 1, true)},
+			wantSaturated: []string{"d1"},
+			wantCluster:   false,
+		},
+		{
+			name:          "without metrics",
+			disks:         []Disk{disk("d1", 100, false), disk("d2", 10, true)},
+			wantSaturated: nil,
+			wantCluster:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			si := StorageInfo{Disks: tt.disks}
+			var got []string
+			for _, d := range si.SaturatedDisks(DiskSaturationThreshold) {
+				got = append(got, d.Endpoint)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantSaturated) {
+				t.Errorf("SaturatedDisks() = %v, want %v", got, tt.wantSaturated)
+			}
+			if c := si.ClusterSaturated(); c != tt.wantCluster {
+				t.Errorf("ClusterSaturated() = %v, want %v", c, tt.wantCluster)
+			}
+		})
+	}
+}
+
+func TestSetFailureBudget(t *testing.T) {
+	info := InfoMessage{
+		Backend: ErasureBackend{
+			StandardSCParity: 2,
+			TotalSets:        []int{3, 1},
+			DrivesPerSet:     []int{6, 4},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {
+				0: {OnlineDisks: 6},
+				1: {OnlineDisks: 5, OfflineDisks: 1},
+				2: {OnlineDisks: 3, OfflineDisks: 3},
+			},
+			1: {
+				// Data equals parity, so only parity-1 drives may be lost.
+				0: {OnlineDisks: 4},
+			},
+		},
+	}
+	want := map[SetRef]int{
+		{Pool: 0, Set: 0}: 2,
+		{Pool: 0, Set: 1}: 1,
+		{Pool: 0, Set: 2}: -1,
+		{Pool: 1, Set: 0}: 1,
+	}
+	got := info.SetFailureBudget()
+	if len(got) != len(want) {
+		t.Fatalf("SetFailureBudget() = %v, want %v", got, want)
+	}
+	for ref, w := range want {
+		if got[ref] != w {
+			t.Errorf("SetFailureBudget()[%+v] = %d, want %d", ref, got[ref], w)
+		}
+	}
+}
+
+func TestServerInfoRaw(t *testing.T) {
+	const body = `{"mode":"online","deploymentID":"deployment","futureField":{"a":1}}`
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+
+	info, raw, err := clnt.ServerInfoRaw(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != body {
+		t.Errorf("ServerInfoRaw() raw = %s, want %s", raw, body)
+	}
+	if info.Mode != "online" || info.DeploymentID != "deployment" {
+		t.Errorf("ServerInfoRaw() info = %+v", info)
+	}
+}
+
+func TestDiskPercentages(t *testing.T) {
+	tests := []struct {
+		name      string
+		disk      Disk
+		wantUsed  float64
+		wantInode float64
+	}{
+		{"empty", Disk{}, 0, 0},
+		{"quarter used", Disk{TotalSpace: 400, UsedSpace: 100, UsedInodes: 30, FreeInodes: 70}, 25, 30},
+		{"full", Disk{TotalSpace: 100, UsedSpace: 100, UsedInodes: 10}, 100, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.disk.UsedPercent(); got != tt.wantUsed {
+				t.Errorf("UsedPercent() = %v, want %v", got, tt.wantUsed)
+			}
+			if got := tt.disk.InodeUsedPercent(); got != tt.wantInode {
+				t.Errorf("InodeUsedPercent() = %v, want %v", got, tt.wantInode)
+			}
+		})
+	}
+}
+
+func TestCanonicalEndpoints(t *testing.T) {
+	info := InfoMessage{
+		Servers: []ServerProperties{
+			{
+				Endpoint: "Node2:9000",
+				Disks: []Disk{
+					{Endpoint: "HTTP://Node2:9000/Data/disk1"},
+					{Endpoint: "http://node2:9000/Data/disk1"},
+				},
+			},
+			{
+				Endpoint: "node1:9000",
+				Disks: []Disk{
+					{Endpoint: "/mnt/Disk1"},
+					{Endpoint: "node1:9000/Export"},
+				},
+			},
+			{Endpoint: "NODE1:9000"},
+		},
+	}
+	want := []string{
+		"/mnt/Disk1",
+		"http://node2:9000/Data/disk1",
+		"node1:9000",
+		"node1:9000/Export",
+		"node2:9000",
+	}
+	for range 3 {
+		// Reorder servers, the result must not change.
+		info.Servers[0], info.Servers[1], info.Servers[2] = info.Servers[2], info.Servers[0], info.Servers[1]
+		if got := info.CanonicalEndpoints(); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("CanonicalEndpoints() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestDiskStatusLegacyMetrics(t *testing.T) {
+	tests := []struct {
+		name       string
+		payload    string
+		wantTokens uint32
+		wantLegacy bool
+	}{
+		{"modern", `{"totalWaiting":1,"totalErrorsAvailability":2,"totalErrorsTimeout":3}`, 0, false},
+		{"empty", `{}`, 0, false},
+		{"legacy", `{"totalTokens":8}`, 8, true},
+		{"legacy with new fields", `{"totalTokens":8,"totalErrorsTimeout":1}`, 8, false},
+		{"legacy float", `{"totalTokens":8.0}`, 8, true},
+		{"legacy string", `{"totalTokens":"8"}`, 8, true},
+		{"legacy invalid", `{"totalTokens":"many","totalWaiting":2}`, 0, false},
+		{"legacy null", `{"totalTokens":null}`, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d DiskStatus
+			if err := json.Unmarshal([]byte(tt.payload), &d); err != nil {
+				t.Fatal(err)
+			}
+			if d.TotalTokens != tt.wantTokens {
+				t.Errorf("TotalTokens = %d, want %d", d.TotalTokens, tt.wantTokens)
+			}
+			if got := d.IsLegacyMetrics(); got != tt.wantLegacy {
+				t.Errorf("IsLegacyMetrics() = %v, want %v", got, tt.wantLegacy)
+			}
+		})
+	}
+
+	var d DiskStatus
+	if err := json.Unmarshal([]byte(`{"totalWaiting":1,"totalErrorsAvailability":2,"totalErrorsTimeout":3}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.TotalWaiting != 1 || d.TotalErrorsAvailability != 2 || d.TotalErrorsTimeout != 3 {
+		t.Errorf("DiskStatus = %+v, want 1, 2 and 3", d)
+	}
+}
+
+func TestCapacityByPool(t *testing.T) {
+	si := StorageInfo{Disks: []Disk{
+		{PoolIndex: 0, TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60},
+		{PoolIndex: 0, TotalSpace: 100, UsedSpace: 20, AvailableSpace: 80},
+		{PoolIndex: 1, TotalSpace: 200, UsedSpace: 50, AvailableSpace: 150},
+		{PoolIndex: -1, TotalSpace: 50, AvailableSpace: 50},
+		{PoolIndex: 0, TotalSpace: 1000, UsedSpace: 1000, RootDisk: true},
+	}}
+	want := map[int]PoolCapacity{
+		-1: {TotalSpace: 50, AvailableSpace: 50},
+		0:  {TotalSpace: 200, UsedSpace: 60, AvailableSpace: 140},
+		1:  {TotalSpace: 200, UsedSpace: 50, AvailableSpace: 150},
+	}
+	got := si.CapacityByPool()
+	if len(got) != len(want) {
+		t.Fatalf("CapacityByPool() = %v, want %v", got, want)
+	}
+	for pool, w := range want {
+		if got[pool] != w {
+			t.Errorf("CapacityByPool()[%d] = %+v, want %+v", pool, got[pool], w)
+		}
+	}
+}
+
+func TestServerInfoHosts(t *testing.T) {
+	var gotHosts []string
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHosts = r.URL.Query()["host"]
+		// Server ignores the host parameter.
+		json.NewEncoder(w).Encode(InfoMessage{
+			DeploymentID: "deployment",
+			Servers: []ServerProperties{
+				{Endpoint: "node1:9000"},
+				{Endpoint: "NODE2:9000"},
+				{Endpoint: "https://node3:9000"},
+			},
+		})
+	}))
+
+	tests := []struct {
+		name     string
+		hosts    []string
+		want     []string
+		wantWarn bool
+	}{
+		{"all", nil, []string{"node1:9000", "NODE2:9000", "https://node3:9000"}, false},
+		{"case insensitive", []string{"Node2"}, []string{"NODE2:9000"}, false},
+		{"host and port", []string{"node1:9000", "node3"}, []string{"node1:9000", "https://node3:9000"}, false},
+		{"some found", []string{"node1", "node5"}, []string{"node1:9000"}, false},
+		{"none found", []string{"node4"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := clnt.ServerInfo(t.Context(), WithServerInfoHosts(tt.hosts...))
+			var warn HostsNotFoundWarning
+			if errors.As(err, &warn) != tt.wantWarn {
+				t.Fatalf("ServerInfo() error = %v, want warning %v", err, tt.wantWarn)
+			}
+			if tt.wantWarn {
+				if !slices.Equal(warn.Hosts, tt.hosts) {
+					t.Errorf("HostsNotFoundWarning.Hosts = %v, want %v", warn.Hosts, tt.hosts)
+				}
+				if len(info.Servers) != 0 || info.DeploymentID != "deployment" {
+					t.Errorf("ServerInfo() = %+v, want an empty but valid message", info)
+				}
+				if info.Servers == nil {
+					t.Error("Servers = nil, want an empty slice")
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(gotHosts) != fmt.Sprint(tt.hosts) {
+				t.Errorf("host query = %v, want %v", gotHosts, tt.hosts)
+			}
+			if info.DeploymentID != "deployment" {
+				t.Errorf("DeploymentID = %q, want deployment", info.DeploymentID)
+			}
+			var got []string
+			for _, srv := range info.Servers {
+				got = append(got, srv.Endpoint)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Servers = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFillPercent(t *testing.T) {
+	si := StorageInfo{Disks: []Disk{
+		{TotalSpace: 1000, UsedSpace: 250},
+		{TotalSpace: 1000, UsedSpace: 350},
+		{TotalSpace: 500, UsedSpace: 500, RootDisk: true},
+	}}
+	du := DataUsageInfo{TotalCapacity: 2000, TotalUsedCapacity: 600}
+	if got := si.FillPercent(); got != 30 {
+		t.Errorf("StorageInfo.FillPercent() = %v, want 30", got)
+	}
+	if got := du.FillPercent(); got != si.FillPercent() {
+		t.Errorf("DataUsageInfo.FillPercent() = %v, want %v", got, si.FillPercent())
+	}
+	if got := (StorageInfo{}).FillPercent(); got != 0 {
+		t.Errorf("empty StorageInfo.FillPercent() = %v, want 0", got)
+	}
+	if got := (DataUsageInfo{}).FillPercent(); got != 0 {
+		t.Errorf("empty DataUsageInfo.FillPercent() = %v, want 0", got)
+	}
+}
+
+func TestServerInfoMsgpack(t *testing.T) {
+	want := InfoMessage{
+		Mode:         "online",
+		DeploymentID: "deployment",
+		Servers:      []ServerProperties{{Endpoint: "node1:9000", State: string(ItemOnline)}},
+	}
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != "application/msgpack" {
+			json.NewEncoder(w).Encode(want)
+			return
+		}
+		b, err := want.MarshalMsg(nil)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/msgpack")
+		w.Write(b)
+	}))
+
+	for _, enabled := range []bool{true, false} {
+		t.Run(fmt.Sprint("msgpack=", enabled), func(t *testing.T) {
+			info, raw, err := clnt.ServerInfoRaw(t.Context(), WithMsgpack(enabled))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if isJSON := json.Valid(raw); isJSON == enabled {
+				t.Errorf("response JSON = %v, want %v", isJSON, !enabled)
+			}
+			if info.Mode != want.Mode || info.DeploymentID != want.DeploymentID ||
+				len(info.Servers) != 1 || info.Servers[0].Endpoint != "node1:9000" {
+				t.Errorf("ServerInfo() = %+v, want %+v", info, want)
+			}
+		})
+	}
+}
+
+func TestIsDegraded(t *testing.T) {
+	tests := []struct {
+		name string
+		info InfoMessage
+		want []string
+	}{
+		{
+			name: "healthy",
+			info: InfoMessage{Servers: []ServerProperties{
+				{Endpoint: "node1:9000", State: string(ItemOnline), Disks: []Disk{{Endpoint: "/disk1"}}},
+			}},
+		},
+		{
+			name: "degraded",
+			info: InfoMessage{
+				Backend: ErasureBackend{OfflineDisks: 2},
+				Servers: []ServerProperties{
+					{Endpoint: "node1:9000", State: string(ItemOnline), Disks: []Disk{{Endpoint: "/disk1", Healing: true}}},
+					{Endpoint: "node2:9000", State: string(ItemOffline)},
+				},
+			},
+			want: []string{
+				"drive /disk1 is healing",
+				"server node2:9000 is offline",
+				"2 drives are offline",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			degraded, reasons := tt.info.IsDegraded()
+			if degraded != (len(tt.want) > 0) || fmt.Sprint(reasons) != fmt.Sprint(tt.want) {
+				t.Errorf("IsDegraded() = %v, %q, want %q", degraded, reasons, tt.want)
+			}
+		})
+	}
+}
+
+func TestTotalTiered(t *testing.T) {
+	du := DataUsageInfo{TierStats: map[string]TierStats{
+		"WARM": {TotalSize: 100, NumVersions: 3, NumObjects: 2},
+		"COLD": {TotalSize: 50, NumVersions: 2, NumObjects: 1},
+	}}
+	if got, want := du.TotalTiered(), (TierStats{TotalSize: 150, NumVersions: 5, NumObjects: 3}); got != want {
+		t.Errorf("TotalTiered() = %+v, want %+v", got, want)
+	}
+	if got := (DataUsageInfo{}).TotalTiered(); got != (TierStats{}) {
+		t.Errorf("TotalTiered() without tiers = %+v, want zero", got)
+	}
+}
+
+func TestNewlyHealingDisks(t *testing.T) {
+	prev := StorageInfo{Disks: []Disk{
+		{UUID: "a"},
+		{UUID: "b", Healing: true},
+		{UUID: "c"},
+	}}
+	cur := StorageInfo{Disks: []Disk{
+		{UUID: "a", Healing: true}, // transitioned into healing
+		{UUID: "b", Healing: true}, // already healing
+		{UUID: "c"},                // not healing
+		{UUID: "d", Healing: true}, // new disk, healing
+		{Healing: true},            // no UUID
+	}}
+	var got []string
+	for _, disk := range NewlyHealingDisks(prev, cur) {
+		got = append(got, disk.UUID)
+	}
+	if want := []string{"a", "d"}; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("NewlyHealingDisks() = %v, want %v", got, want)
+	}
+}
+
+func TestEstimateIOPSHeadroom(t *testing.T) {
+	prev := StorageInfo{Disks: []Disk{
+		{Endpoint: "d1", Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 100, "WriteAll": 100}}},
+		{Endpoint: "d2", Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 0}}},
+		{Endpoint: "d3", Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 0}}},
+		{Endpoint: "d4"},
+	}}
+	cur := StorageInfo{Disks: []Disk{
+		// 1000 calls in 10s: 100 IOPS at 25% utilization.
+		{Endpoint: "d1", Utilization: 25, Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 700, "WriteAll": 500}}},
+		// 500 IOPS at full utilization.
+		{Endpoint: "d2", Utilization: 100, Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 5000}}},
+		// Idle, no estimate.
+		{Endpoint: "d3", Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 0}}},
+		// Metrics missing from the previous snapshot.
+		{Endpoint: "d4", Utilization: 50, Metrics: &DiskStatus{APICalls: map[string]uint64{"ReadAll": 10}}},
+	}}
+	want := map[string]float64{"d1": 300, "d2": 0}
+	got := EstimateIOPSHeadroom(prev, cur, 10*time.Second)
+	if len(got) != len(want) {
+		t.Fatalf("EstimateIOPSHeadroom() = %v, want %v", got, want)
+	}
+	for endpoint, w := range want {
+		if math.Abs(got[endpoint]-w) > 1e-9 {
+			t.Errorf("EstimateIOPSHeadroom()[%s] = %v, want %v", endpoint, got[endpoint], w)
+		}
+	}
+	if got := EstimateIOPSHeadroom(prev, cur, 0); len(got) != 0 {
+		t.Errorf("EstimateIOPSHeadroom() with no elapsed time = %v, want empty", got)
+	}
+}
+
+func TestInfoGzipResponses(t *testing.T) {
+	var gzipped atomic.Int32
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v any
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/info"):
+			v = InfoMessage{DeploymentID: "deployment"}
+		case strings.HasSuffix(r.URL.Path, "/storageinfo"):
+			v = StorageInfo{Disks: []Disk{{Endpoint: "/disk1"}}}
+		case strings.HasSuffix(r.URL.Path, "/datausageinfo"):
+			v = DataUsageInfo{BucketsCount: 3}
+		}
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			json.NewEncoder(w).Encode(v)
+			return
+		}
+		gzipped.Add(1)
+		w.Header().Set("Content-Encoding", "gzip")
+		gw := gzip.NewWriter(w)
+		json.NewEncoder(gw).Encode(v)
+		gw.Close()
+	}))
+
+	info, err := clnt.ServerInfo(t.Context())
+	if err != nil || info.DeploymentID != "deployment" {
+		t.Errorf("ServerInfo() = %+v, %v", info, err)
+	}
+	_, raw, err := clnt.ServerInfoRaw(t.Context())
+	if err != nil || !json.Valid(raw) {
+		t.Errorf("ServerInfoRaw() = %s, %v, want decompressed JSON", raw, err)
+	}
+	si, err := clnt.StorageInfo(t.Context())
+	if err != nil || len(si.Disks) != 1 {
+		t.Errorf("StorageInfo() = %+v, %v", si, err)
+	}
+	du, err := clnt.DataUsageInfo(t.Context())
+	if err != nil || du.BucketsCount != 3 {
+		t.Errorf("DataUsageInfo() = %+v, %v", du, err)
+	}
+	if got := gzipped.Load(); got != 4 {
+		t.Errorf("%d gzip responses, want 4", got)
+	}
+}
+
+func TestInfoGzipErrorResponses(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("%s: gzip not requested", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusForbidden)
+		gw := gzip.NewWriter(w)
+		io.WriteString(gw, `{"Code":"AccessDenied","Message":"Access Denied."}`)
+		gw.Close()
+	}))
+
+	check := func(call string, err error) {
+		t.Helper()
+		if resp := ToErrorResponse(err); resp.Code != "AccessDenied" || resp.Message != "Access Denied." {
+			t.Errorf("%s error = %#v, want decoded AccessDenied", call, err)
+		}
+	}
+	_, err := clnt.ServerInfo(t.Context())
+	check("ServerInfo()", err)
+	_, err = clnt.StorageInfo(t.Context())
+	check("StorageInfo()", err)
+	_, err = clnt.DataUsageInfo(t.Context())
+	check("DataUsageInfo()", err)
+	_, err = clnt.StorageInfoStream(t.Context())
+	check("StorageInfoStream()", err)
+}
+
+func TestConfiguredStorageClasses(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend ErasureBackend
+		want    []string
+	}{
+		{"standard only", ErasureBackend{Type: "Erasure", StandardSCParity: 4}, []string{"STANDARD"}},
+		{"standard and rrs", ErasureBackend{Type: "Erasure", StandardSCParity: 4, RRSCParity: 2}, []string{"STANDARD", "REDUCED_REDUNDANCY"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := InfoMessage{Backend: tt.backend}
+			if got := info.ConfiguredStorageClasses(); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("ConfiguredStorageClasses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackendDisksMerge(t *testing.T) {
+	d1 := BackendDisks{"node1": 2, "node2": 1}
+	d2 := BackendDisks{"node1": 1, "node3": 4}
+	if got := d1.Merge(d2); fmt.Sprint(got) != "map[node1:3 node2:1]" {
+		t.Errorf("Merge() = %v, want map[node1:3 node2:1]", got)
+	}
+	if got := d1.Merge(nil); fmt.Sprint(got) != "map[node1:2 node2:1]" {
+		t.Errorf("Merge(nil) = %v, want map[node1:2 node2:1]", got)
+	}
+	if got := (BackendDisks{}).Merge(d2); len(got) != 0 {
+		t.Errorf("Merge() on empty = %v, want empty", got)
+	}
+}
+
+func TestMergeStorageInfo(t *testing.T) {
+	a := StorageInfo{Disks: []Disk{{Endpoint: "a1"}, {Endpoint: "a2"}}}
+	a.Backend.Type = Erasure
+	a.Backend.OnlineDisks = BackendDisks{"node1": 2}
+	a.Backend.OfflineDisks = BackendDisks{"node1": 0}
+	a.Backend.StandardSCData = []int{2}
+	a.Backend.StandardSCParities = []int{2}
+	a.Backend.TotalSets = []int{1}
+	a.Backend.DrivesPerSet = []int{4}
+
+	b := StorageInfo{Disks: []Disk{{Endpoint: "b1"}}}
+	b.Backend.Type = Erasure
+	b.Backend.OnlineDisks = BackendDisks{"node1": 1, "node2": 3}
+	b.Backend.OfflineDisks = BackendDisks{"node2": 1}
+	b.Backend.StandardSCData = []int{4}
+	b.Backend.StandardSCParities = []int{4}
+	b.Backend.TotalSets = []int{2}
+	b.Backend.DrivesPerSet = []int{8}
+
+	got := MergeStorageInfo(a, b)
+	if len(got.Disks) != 3 || got.Disks[2].Endpoint != "b1" {
+		t.Errorf("Disks = %+v", got.Disks)
+	}
+	if got.Backend.Type != Erasure {
+		t.Errorf("Type = %v, want %v", got.Backend.Type, Erasure)
+	}
+	if fmt.Sprint(got.Backend.OnlineDisks) != "map[node1:3 node2:3]" {
+		t.Errorf("OnlineDisks = %v", got.Backend.OnlineDisks)
+	}
+	if fmt.Sprint(got.Backend.OfflineDisks) != "map[node1:0 node2:1]" {
+		t.Errorf("OfflineDisks = %v", got.Backend.OfflineDisks)
+	}
+	if fmt.Sprint(got.Backend.StandardSCData, got.Backend.StandardSCParities, got.Backend.TotalSets, got.Backend.DrivesPerSet) != "[2 4] [2 4] [1 2] [4 8]" {
+		t.Errorf("per pool values = %v %v %v %v", got.Backend.StandardSCData, got.Backend.StandardSCParities, got.Backend.TotalSets, got.Backend.DrivesPerSet)
+	}
+
+	var fs StorageInfo
+	fs.Backend.Type = FS
+	if got := MergeStorageInfo(a, fs); got.Backend.Type != Unknown {
+		t.Errorf("Type = %v, want %v", got.Backend.Type, Unknown)
+	}
+}
+
+func TestBucketObjectSkew(t *testing.T) {
+	usage := func(counts ...uint64) map[string]BucketUsageInfo {
+		m := make(map[string]BucketUsageInfo)
+		for i, c := range counts {
+			m[fmt.Sprint("bucket", i)] = BucketUsageInfo{ObjectsCount: c}
+		}
+		return m
+	}
+	tests := []struct {
+		name string
+		du   DataUsageInfo
+		want float64
+	}{
+		{"empty", DataUsageInfo{}, 0},
+		{"single bucket", DataUsageInfo{BucketsUsage: usage(100)}, 0},
+		{"no objects", DataUsageInfo{BucketsUsage: usage(0, 0, 0)}, 0},
+		{"uniform", DataUsageInfo{BucketsUsage: usage(10, 10, 10, 10)}, 0},
+		{"skewed", DataUsageInfo{BucketsUsage: usage(0, 0, 0, 100)}, 0.75},
+		{"mixed", DataUsageInfo{BucketsUsage: usage(1, 2, 3, 4)}, 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.du.BucketObjectSkew(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("BucketObjectSkew() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlowestAPIs(t *testing.T) {
+	d := DiskStatus{LastMinute: map[string]TimedAction{
+		"ReadAll":    {Count: 10, AccTime: 100},
+		"WriteAll":   {Count: 2, AccTime: 200},
+		"StatInfo":   {Count: 5, AccTime: 50},
+		"DeleteFile": {Count: 1, AccTime: 10},
+		"ListDir":    {},
+	}}
+	tests := []struct {
+		n    int
+		want []APILatency
+	}{
+		{2, []APILatency{{"WriteAll", 100, 2}, {"DeleteFile", 10, 1}}},
+		{4, []APILatency{{"WriteAll", 100, 2}, {"DeleteFile", 10, 1}, {"ReadAll", 10, 10}, {"StatInfo", 10, 5}}},
+		{10, []APILatency{{"WriteAll", 100, 2}, {"DeleteFile", 10, 1}, {"ReadAll", 10, 10}, {"StatInfo", 10, 5}, {"ListDir", 0, 0}}},
+		{0, []APILatency{}},
+	}
+	for _, tt := range tests {
+		if got := d.SlowestAPIs(tt.n); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("SlowestAPIs(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if got := (DiskStatus{}).SlowestAPIs(3); got == nil || len(got) != 0 {
+		t.Errorf("SlowestAPIs() without metrics = %#v, want empty slice", got)
+	}
+}
+
+func TestCountsReported(t *testing.T) {
+	const payload = `{"buckets":{"count":0},"versions":{"count":3},"deletemarkers":{"count":0},"usage":{"size":0}}`
+	var info InfoMessage
+	if err := json.Unmarshal([]byte(payload), &info); err != nil {
+		t.Fatal(err)
+	}
+	if info.CountsReported() {
+		t.Error("CountsReported() = true without objects")
+	}
+	if !info.Buckets.Reported || info.Buckets.Count != 0 {
+		t.Errorf("Buckets = %+v, want a reported zero count", info.Buckets)
+	}
+	if info.Objects.Reported {
+		t.Errorf("Objects = %+v, want not reported", info.Objects)
+	}
+
+	// Counts that were not reported stay so across JSON and msgp round
+	// trips, reported zero counts are kept.
+	b, err := json.Marshal(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["objects"]; ok {
+		t.Errorf("objects present after round trip: %s", b)
+	}
+	if string(fields["buckets"]) != `{"count":0}` {
+		t.Errorf("buckets = %s after round trip, want {\"count\":0}", fields["buckets"])
+	}
+	var again InfoMessage
+	if err = json.Unmarshal(b, &again); err != nil {
+		t.Fatal(err)
+	}
+	if again.Objects.Reported || again.Buckets != info.Buckets || again.Versions != info.Versions {
+		t.Errorf("JSON round trip changed counts: %+v, want %+v", again, info)
+	}
+	if b, err = info.MarshalMsg(nil); err != nil {
+		t.Fatal(err)
+	}
+	again = InfoMessage{}
+	if _, err = again.UnmarshalMsg(b); err != nil {
+		t.Fatal(err)
+	}
+	if again.Objects.Reported || again.Buckets != info.Buckets || again.Versions != info.Versions {
+		t.Errorf("msgp round trip changed counts: %+v, want %+v", again, info)
+	}
+
+	if b, err = json.Marshal(info.Snapshot()); err != nil {
+		t.Fatal(err)
+	}
+	var snapshot InfoSnapshot
+	if err = json.Unmarshal(b, &snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if restored := snapshot.Message(); restored.Objects.Reported || !restored.Buckets.Reported {
+		t.Errorf("snapshot round trip changed counts: %+v, want %+v", restored, info)
+	}
+
+	// A producer sends a zero count by marking it reported.
+	if b, err = json.Marshal(InfoMessage{Buckets: Buckets{Reported: true}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if string(fields["buckets"]) != `{"count":0}` {
+		t.Errorf("buckets = %s, want {\"count\":0}", fields["buckets"])
+	}
+
+	info.Objects.Count = 0
+	if err = json.Unmarshal([]byte(`{"count":0}`), &info.Objects); err != nil {
+		t.Fatal(err)
+	}
+	if !info.CountsReported() {
+		t.Error("CountsReported() = false with all counts reported")
+	}
+}
+
+func TestCountsReportedMsgp(t *testing.T) {
+	const payload = `{"buckets":{"count":0},"objects":{"count":0},"versions":{"count":3},"deletemarkers":{"count":0},"usage":{"size":0}}`
+	var info InfoMessage
+	if err := json.Unmarshal([]byte(payload), &info); err != nil {
+		t.Fatal(err)
+	}
+	if !info.CountsReported() {
+		t.Fatal("CountsReported() = false before msgp round trip")
+	}
+
+	b, err := info.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again InfoMessage
+	if _, err = again.UnmarshalMsg(b); err != nil {
+		t.Fatal(err)
+	}
+	if !again.CountsReported() {
+		t.Errorf("CountsReported() = false after msgp round trip: %+v", again)
+	}
+	if again.Buckets != info.Buckets || again.Versions != info.Versions || again.Usage != info.Usage {
+		t.Errorf("msgp round trip changed counts: %+v, want %+v", again, info)
+	}
+
+	var decoded InfoMessage
+	if err = decoded.DecodeMsg(msgp.NewReader(bytes.NewReader(b))); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.CountsReported() {
+		t.Errorf("CountsReported() = false after msgp decode: %+v", decoded)
+	}
+
+	// Non-zero counts set by a producer are reported, unset ones are not.
+	producer := InfoMessage{Versions: Versions{Count: 3}}
+	b, err = producer.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again = InfoMessage{}
+	if _, err = again.UnmarshalMsg(b); err != nil {
+		t.Fatal(err)
+	}
+	if again.Buckets.Reported || !again.Versions.Reported || again.Versions.Count != 3 {
+		t.Errorf("msgp round trip = %+v, want only versions reported", again)
+	}
+}
+
+func TestInconsistentLeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		servers []ServerProperties
+		want    []string
+	}{
+		{
+			name: "healthy leader",
+			servers: []ServerProperties{
+				{Endpoint: "node1:9000", State: string(ItemOnline), IsLeader: true},
+				{Endpoint: "node2:9000", State: string(ItemOffline)},
+			},
+		},
+		{
+			name: "offline leader",
+			servers: []ServerProperties{
+				{Endpoint: "node1:9000", State: string(ItemOnline)},
+				{Endpoint: "node2:9000", State: string(ItemOffline), IsLeader: true},
+			},
+			want: []string{"node2:9000"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := InfoMessage{Servers: tt.servers}
+			if got := info.InconsistentLeaders(); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("InconsistentLeaders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInodeAlerts(t *testing.T) {
+	disk := func(endpoint string, used, free uint64) Disk {
+		return Disk{Endpoint: endpoint, DrivePath: "/data/" + endpoint, UsedInodes: used, FreeInodes: free}
+	}
+	si := StorageInfo{Disks: []Disk{
+		disk("below", 79, 21),
+		disk("warn", 80, 20),
+		disk("belowcrit", 94, 6),
+		disk("crit", 95, 5),
+		disk("full", 100, 0),
+		disk("unreported", 0, 0),
+	}}
+	want := []InodeAlert{
+		{"warn", "/data/warn", 0.8, AlertWarning},
+		{"belowcrit", "/data/belowcrit", 0.94, AlertWarning},
+		{"crit", "/data/crit", 0.95, AlertCritical},
+		{"full", "/data/full", 1, AlertCritical},
+	}
+	got := si.InodeAlerts(80, 95)
+	if len(got) != len(want) {
+		t.Fatalf("InodeAlerts() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Endpoint != want[i].Endpoint || got[i].Path != want[i].Path ||
+			got[i].Severity != want[i].Severity || math.Abs(got[i].Ratio-want[i].Ratio) > 1e-9 {
+			t.Errorf("InodeAlerts()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestServerUptime(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		uptime      int64
+		wantUptime  time.Duration
+		wantStarted time.Time
+	}{
+		{3600, time.Hour, now.Add(-time.Hour)},
+		{0, 0, now},
+		{-5, 0, now},
+	}
+	for _, tt := range tests {
+		srv := ServerProperties{Uptime: tt.uptime}
+		if got := srv.UptimeDuration(); got != tt.wantUptime {
+			t.Errorf("UptimeDuration() with uptime %d = %v, want %v", tt.uptime, got, tt.wantUptime)
+		}
+		if got := srv.StartedAt(now); !got.Equal(tt.wantStarted) {
+			t.Errorf("StartedAt() with uptime %d = %v, want %v", tt.uptime, got, tt.wantStarted)
+		}
+	}
+}
+
+func TestReplicationThroughput(t *testing.T) {
+	prev := DataUsageInfo{ReplicatedSize: 1000, ReplicationFailedSize: 100}
+	tests := []struct {
+		name        string
+		cur         DataUsageInfo
+		elapsed     time.Duration
+		wantRate    float64
+		wantSuccess float64
+	}{
+		{"steady", DataUsageInfo{ReplicatedSize: 2000, ReplicationFailedSize: 100}, 10 * time.Second, 100, 1},
+		{"some failures", DataUsageInfo{ReplicatedSize: 1900, ReplicationFailedSize: 200}, 10 * time.Second, 90, 0.9},
+		{"stalled", DataUsageInfo{ReplicatedSize: 1000, ReplicationFailedSize: 600}, 10 * time.Second, 0, 0},
+		{"idle", DataUsageInfo{ReplicatedSize: 1000, ReplicationFailedSize: 100}, 10 * time.Second, 0, 1},
+		{"counter reset", DataUsageInfo{ReplicatedSize: 500, ReplicationFailedSize: 0}, 10 * time.Second, 0, 1},
+		{"no elapsed time", DataUsageInfo{ReplicatedSize: 2000, ReplicationFailedSize: 100}, 0, 0, 1},
+		// The success rate is weighed by size, the failed count is ignored.
+		{"failed count only", DataUsageInfo{ReplicatedSize: 2000, ReplicationFailedSize: 100, ReplicationFailedCount: 50}, 10 * time.Second, 100, 1},
+		{"large failure", DataUsageInfo{ReplicatedSize: 1100, ReplicationFailedSize: 500, ReplicationFailedCount: 1}, 10 * time.Second, 10, 0.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, success := ReplicationThroughput(prev, tt.cur, tt.elapsed)
+			if math.Abs(rate-tt.wantRate) > 1e-9 || math.Abs(success-tt.wantSuccess) > 1e-9 {
+				t.Errorf("ReplicationThroughput() = %v, %v, want %v, %v", rate, success, tt.wantRate, tt.wantSuccess)
+			}
+		})
+	}
+}
+
+func TestStorageInfoStream(t *testing.T) {
+	want := StorageInfo{Disks: []Disk{
+		{Endpoint: "/disk1", State: DriveStateOk},
+		{Endpoint: "/disk2", State: DriveStateOffline},
+		{Endpoint: "/disk3", State: DriveStateOk},
+	}}
+	want.Backend.Type = Erasure
+	want.Backend.TotalSets = []int{1}
+	want.Backend.DrivesPerSet = []int{3}
+
+	tests := []struct {
+		name string
+		body func() []byte
+	}{
+		{"encoded", func() []byte {
+			b, _ := json.Marshal(want)
+			return b
+		}},
+		{"backend first", func() []byte {
+			backend, _ := json.Marshal(want.Backend)
+			disks, _ := json.Marshal(want.Disks)
+			return fmt.Appendf(nil, `{"Backend":%s,"Extra":{"a":[1]},"Disks":%s}`, backend, disks)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write(tt.body())
+			}))
+			stream, err := clnt.StorageInfoStream(t.Context())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stream.Close()
+
+			var got []string
+			for {
+				disk, err := stream.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, disk.Endpoint+"="+disk.State)
+			}
+			if want := "[/disk1=ok /disk2=offline /disk3=ok]"; fmt.Sprint(got) != want {
+				t.Errorf("disks = %v, want %v", got, want)
+			}
+			if b := stream.Backend(); b.Type != Erasure || fmt.Sprint(b.TotalSets, b.DrivesPerSet) != "[1] [3]" {
+				t.Errorf("Backend() = %+v", b)
+			}
+			if _, err = stream.Next(); !errors.Is(err, io.EOF) {
+				t.Errorf("Next() after end = %v, want %v", err, io.EOF)
+			}
+		})
+	}
+
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Disks":null,"Backend":{"Type":1}}`))
+	}))
+	stream, err := clnt.StorageInfoStream(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next() without disks = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestReplicationRole(t *testing.T) {
+	tests := []struct {
+		replicated, replica uint64
+		want                ReplicationRole
+	}{
+		{0, 0, ReplicationNone},
+		{10, 0, ReplicationSource},
+		{0, 10, ReplicationTarget},
+		{10, 10, ReplicationBoth},
+	}
+	for _, tt := range tests {
+		du := DataUsageInfo{ReplicatedSize: tt.replicated, ReplicaSize: tt.replica}
+		if got := du.ReplicationRole(); got != tt.want {
+			t.Errorf("ReplicationRole() with replicated %d and replica %d = %v, want %v", tt.replicated, tt.replica, got, tt.want)
+		}
+	}
+}
+
+func TestDataUsageInfoCapacity(t *testing.T) {
+	var capacity string
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capacity = r.URL.Query().Get("capacity")
+		json.NewEncoder(w).Encode(DataUsageInfo{})
+	}))
+	tests := []struct {
+		name    string
+		options []func(*DataUsageOpts)
+		want    string
+	}{
+		{"default", nil, "true"},
+		{"with capacity", []func(*DataUsageOpts){WithCapacity(true)}, "true"},
+		{"without capacity", []func(*DataUsageOpts){WithCapacity(false)}, "false"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := clnt.DataUsageInfo(t.Context(), tt.options...); err != nil {
+				t.Fatal(err)
+			}
+			if capacity != tt.want {
+				t.Errorf("capacity = %q, want %q", capacity, tt.want)
+			}
+		})
+	}
+}
+
+func TestErasureSetHealth(t *testing.T) {
+	tests := []struct {
+		name      string
+		set       ErasureSetInfo
+		wantRatio float64
+		wantHeal  bool
+	}{
+		{"empty", ErasureSetInfo{}, 0, false},
+		{"healthy", ErasureSetInfo{OnlineDisks: 8}, 100, false},
+		{"offline", ErasureSetInfo{OnlineDisks: 6, OfflineDisks: 2}, 75, true},
+		{"healing", ErasureSetInfo{OnlineDisks: 8, HealDisks: 1}, 100, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.set.HealthRatio(); got != tt.wantRatio {
+				t.Errorf("HealthRatio() = %v, want %v", got, tt.wantRatio)
+			}
+			if got := tt.set.NeedsHeal(); got != tt.wantHeal {
+				t.Errorf("NeedsHeal() = %v, want %v", got, tt.wantHeal)
+			}
+		})
+	}
+}
+
+func TestDiskIdentityKey(t *testing.T) {
+	tests := []struct {
+		name string
+		disk Disk
+		want string
+	}{
+		{"uuid", Disk{UUID: "uuid", Endpoint: "node1:9000", DrivePath: "/disk1"}, "uuid"},
+		{"no uuid", Disk{Endpoint: "node1:9000", DrivePath: "/disk1"}, "node1:9000\x00/disk1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.disk.IdentityKey(); got != tt.want {
+				t.Errorf("IdentityKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	a := Disk{Endpoint: "h:9000", DrivePath: "/d1"}
+	b := Disk{Endpoint: "h:900", DrivePath: "0/d1"}
+	if a.IdentityKey() == b.IdentityKey() {
+		t.Errorf("IdentityKey() = %q for both %+v and %+v", a.IdentityKey(), a, b)
+	}
+}
+
+func TestDaysUntilFirstDriveFull(t *testing.T) {
+	sample := func(usedA, usedB uint64) StorageInfo {
+		return StorageInfo{Disks: []Disk{
+			{UUID: "a", TotalSpace: 1000, UsedSpace: usedA},
+			{UUID: "b", TotalSpace: 2000, UsedSpace: usedB},
+			{UUID: "root", TotalSpace: 100, UsedSpace: usedA, RootDisk: true},
+		}}
+	}
+
+	// Disk a grows by 100 per day with 600 left, disk b by 100 per day with 1500 left.
+	growing := []StorageInfo{sample(200, 300), sample(300, 400), sample(400, 500)}
+	uuid, days, ok := DaysUntilFirstDriveFull(growing)
+	if !ok || uuid != "a" || math.Abs(days-6) > 1e-9 {
+		t.Errorf("DaysUntilFirstDriveFull() = %q, %v, %v, want a, 6, true", uuid, days, ok)
+	}
+
+	flat := []StorageInfo{sample(200, 300), sample(200, 300), sample(200, 300)}
+	if _, _, ok = DaysUntilFirstDriveFull(flat); ok {
+		t.Error("DaysUntilFirstDriveFull() with flat usage returned ok")
+	}
+	if _, _, ok = DaysUntilFirstDriveFull(growing[:1]); ok {
+		t.Error("DaysUntilFirstDriveFull() with a single sample returned ok")
+	}
+}
+
+func TestParsedSizeHistogram(t *testing.T) {
+	b := BucketUsageInfo{ObjectSizesHistogram: map[string]uint64{
+		"GREATER_THAN_512_MB":     1,
+		"BETWEEN_1024B_AND_1_MB":  7,
+		"LESS_THAN_1024_B":        10,
+		"BETWEEN_1024B_AND_64_KB": 5,
+		"BETWEEN_512KB_AND_1_MB":  2,
+	}}
+	got, err := b.ParsedSizeHistogram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []SizeBucket{
+		{"LESS_THAN_1024_B", 0, 1023, 10},
+		{"BETWEEN_1024B_AND_64_KB", 1024, 64<<10 - 1, 5},
+		{"BETWEEN_1024B_AND_1_MB", 1024, 1<<20 - 1, 7},
+		{"BETWEEN_512KB_AND_1_MB", 512 << 10, 1<<20 - 1, 2},
+		{"GREATER_THAN_512_MB", 512 << 20, math.MaxInt64, 1},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ParsedSizeHistogram() = %v, want %v", got, want)
+	}
+
+	b.ObjectSizesHistogram["BETWEEN_1_GB_AND_2_GB"] = 1
+	if _, err = b.ParsedSizeHistogram(); err == nil || !strings.Contains(err.Error(), "BETWEEN_1_GB_AND_2_GB") {
+		t.Errorf("ParsedSizeHistogram() error = %v, want error naming the unknown key", err)
+	}
+}
+
+func TestDiskMediaType(t *testing.T) {
+	// reads returns metrics of 10 read calls of avg each over the last minute.
+	reads := func(avg time.Duration) *DiskStatus {
+		return &DiskStatus{LastMinute: map[string]TimedAction{
+			"ReadXL":   {Count: 4, AccTime: 4 * uint64(avg)},
+			"ReadFile": {Count: 6, AccTime: 6 * uint64(avg)},
+		}}
+	}
+	tests := []struct {
+		name string
+		disk Disk
+		want DriveMediaType
+	}{
+		{"nvme model", Disk{Model: "Samsung NVMe 980 PRO"}, MediaSSD},
+		{"ssd model", Disk{Model: "INTEL SSDSC2KB960G8"}, MediaSSD},
+		{"hdd model", Disk{Model: "ST12000NM0008"}, MediaUnknown},
+		{"fast drive", Disk{Model: "X123", Metrics: reads(200 * time.Microsecond)}, MediaSSD},
+		{"slow drive", Disk{Model: "ST12000NM0008", Metrics: reads(8 * time.Millisecond)}, MediaHDD},
+		{"inconclusive latency", Disk{Metrics: reads(3 * time.Millisecond)}, MediaUnknown},
+		{"no reads", Disk{Metrics: &DiskStatus{LastMinute: map[string]TimedAction{"WriteAll": {Count: 1, AccTime: 1}}}}, MediaUnknown},
+		{"no metrics", Disk{Model: "X123", ReadLatency: 8}, MediaUnknown},
+		{"empty", Disk{}, MediaUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.disk.MediaType(); got != tt.want {
+				t.Errorf("MediaType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStorageInfoWritePrometheus(t *testing.T) {
+	si := StorageInfo{Disks: []Disk{
+		{Endpoint: "http://node1:9000/disk1", State: DriveStateOk, TotalSpace: 1000, UsedSpace: 100, PoolIndex: 0, SetIndex: 1},
+		{Endpoint: `/mnt/"disk2"`, State: DriveStateOffline, TotalSpace: 2000, PoolIndex: 1, SetIndex: 0},
+	}}
+	var buf strings.Builder
+	if err := si.WritePrometheus(&buf, map[string]string{"server": "minio", "cluster": "eu-1", "pool": "ignored"}); err != nil {
+		t.Fatal(err)
+	}
+	want := `# HELP minio_disk_total_bytes Total space of the disk in bytes.
+# TYPE minio_disk_total_bytes gauge
+minio_disk_total_bytes{cluster="eu-1",server="minio",endpoint="http://node1:9000/disk1",pool="0",set="1"} 1000
+minio_disk_total_bytes{cluster="eu-1",server="minio",endpoint="/mnt/\"disk2\"",pool="1",set="0"} 2000
+# HELP minio_disk_used_bytes Used space of the disk in bytes.
+# TYPE minio_disk_used_bytes gauge
+minio_disk_used_bytes{cluster="eu-1",server="minio",endpoint="http://node1:9000/disk1",pool="0",set="1"} 100
+minio_disk_used_bytes{cluster="eu-1",server="minio",endpoint="/mnt/\"disk2\"",pool="1",set="0"} 0
+# HELP minio_disk_online Whether the disk is online (1) or not (0).
+# TYPE minio_disk_online gauge
+minio_disk_online{cluster="eu-1",server="minio",endpoint="http://node1:9000/disk1",pool="0",set="1"} 1
+minio_disk_online{cluster="eu-1",server="minio",endpoint="/mnt/\"disk2\"",pool="1",set="0"} 0
+`
+	if got := buf.String(); got != want {
+		t.Errorf("WritePrometheus() =\n%s\nwant\n%s", got, want)
+	}
+
+	// The output must be parseable.
+	families, err := ParsePrometheusResults(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(families) != 3 {
+		t.Errorf("parsed %d metric families, want 3", len(families))
+	}
+
+	if err = si.WritePrometheus(&buf, map[string]string{"1bad": "x"}); err == nil {
+		t.Error("WritePrometheus() accepted an invalid label name")
+	}
+}
+
+func TestServerInfoRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int // statuses returned before succeeding
+		attempts     int
+		wantCalls    int32
+		wantErr      bool
+		wantAttempts string
+	}{
+		{"recovers", []int{http.StatusInternalServerError, http.StatusInternalServerError}, 3, 3, false, ""},
+		{"exhausted", []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}, 2, 2, true, "after 2 attempts"},
+		{"not retryable", []int{http.StatusForbidden}, 3, 1, true, ""},
+		{"no retry", []int{http.StatusInternalServerError}, 0, 1, true, ""},
+		{"retried by every call", []int{http.StatusServiceUnavailable}, 3, 1, true, ""},
+	}
+	// Have executeMethod make a single request, so the calls counted are
+	// those made by WithRetry.
+	defer func(maxRetry int) { MaxRetry = maxRetry }(MaxRetry)
+	MaxRetry = 1
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if n := int(calls.Add(1)); n <= len(tt.statuses) {
+					w.WriteHeader(tt.statuses[n-1])
+					return
+				}
+				json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+			}))
+
+			info, err := clnt.ServerInfo(t.Context(), WithRetry(tt.attempts, time.Millisecond))
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("ServerInfo() made %d calls, want %d", got, tt.wantCalls)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("ServerInfo() returned no error")
+				}
+				if !strings.Contains(err.Error(), tt.wantAttempts) || (tt.wantAttempts == "" && strings.Contains(err.Error(), "attempt")) {
+					t.Errorf("ServerInfo() error = %v, want %q", err, tt.wantAttempts)
+				}
+				var errResp ErrorResponse
+				if !errors.As(err, &errResp) {
+					t.Errorf("ServerInfo() error = %v, want an ErrorResponse", err)
+				}
+				return
+			}
+			if err != nil || info.DeploymentID != "deployment" {
+				t.Errorf("ServerInfo() = %+v, %v", info, err)
+			}
+		})
+	}
+}
+
+func TestServerInfoRetryTransient(t *testing.T) {
+	// Failures retried by executeMethod are not retried again by WithRetry,
+	// which would multiply the attempts by MaxRetry.
+	defer func(maxRetry int) { MaxRetry = maxRetry }(MaxRetry)
+	MaxRetry = 2
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable} {
+		var calls atomic.Int32
+		clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(status)
+		}))
+		if _, err := clnt.ServerInfo(t.Context(), WithRetry(3, time.Millisecond)); err == nil {
+			t.Fatalf("ServerInfo() returned no error on %d", status)
+		}
+		if got := calls.Load(); got != int32(MaxRetry) {
+			t.Errorf("ServerInfo() made %d calls on %d, want %d", got, status, MaxRetry)
+		}
+	}
+}
+
+// roundTripFunc adapts a function to an http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestServerInfoRetryConnRefused(t *testing.T) {
+	// Reserve an address nothing listens on, as a node restarting.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	// Bring the node up once a connection was refused.
+	var refused atomic.Int32
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	rt := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		resp, err := transport.RoundTrip(r)
+		if errors.Is(err, syscall.ECONNREFUSED) && refused.Add(1) == 1 {
+			l, lerr := net.Listen("tcp", addr)
+			if lerr != nil {
+				t.Error(lerr)
+				return resp, err
+			}
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+			}))
+			srv.Listener.Close()
+			srv.Listener = l
+			srv.Start()
+			t.Cleanup(srv.Close)
+		}
+		return resp, err
+	})
+	clnt, err := NewWithOptions(addr, &Options{
+		Creds:     credentials.NewStaticV4("minioadmin", "minioadmin", ""),
+		Transport: rt,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := clnt.ServerInfo(t.Context(), WithRetry(3, time.Millisecond))
+	if err != nil || info.DeploymentID != "deployment" {
+		t.Errorf("ServerInfo() = %+v, %v, want a response once the node is up", info, err)
+	}
+	if got := refused.Load(); got != 1 {
+		t.Errorf("ServerInfo() had %d connections refused, want 1", got)
+	}
+}
+
+func TestBackendDisksDiff(t *testing.T) {
+	before := BackendDisks{"node1": 4, "node2": 4, "node3": 2}
+	after := BackendDisks{"node1": 4, "node2": 1, "node4": 3}
+	want := map[string]int{"node1": 0, "node2": -3, "node3": -2, "node4": 3}
+	got := before.Diff(after)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+}
+
+func TestInfoMessageValidate(t *testing.T) {
+	valid := InfoMessage{
+		Backend: ErasureBackend{Type: "Erasure", OnlineDisks: 3, OfflineDisks: 1, TotalSets: []int{1}, DrivesPerSet: []int{4}},
+		Servers: []ServerProperties{
+			{Disks: []Disk{{}, {}}},
+			{Disks: []Disk{{}, {}}},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{0: {0: {}}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	invalid := InfoMessage{
+		Backend: ErasureBackend{Type: "Erasure", OnlineDisks: 4, TotalSets: []int{1, 1}, DrivesPerSet: []int{4}},
+		Servers: []ServerProperties{{Disks: []Disk{{}, {}}}},
+		Pools:   map[int]map[int]ErasureSetInfo{-1: {0: {}}, 0: {-2: {}}},
+	}
+	want := []string{
+		"backend reports 2 pools with sets but 1 pools with drives per set",
+		"backend reports 4 drives but servers report 2",
+		"invalid pool index -1",
+		"invalid set index -2 in pool 0",
+	}
+	err := invalid.Validate()
+	if err == nil {
+		t.Fatal("Validate() = nil, want error")
+	}
+	if got := err.Error(); got != strings.Join(want, "\n") {
+		t.Errorf("Validate() = %q, want %q", got, want)
+	}
+
+	fs := InfoMessage{Backend: ErasureBackend{Type: "FS"}, Servers: []ServerProperties{{Disks: []Disk{{}}}}}
+	if err = fs.Validate(); err != nil {
+		t.Errorf("Validate() for FS backend = %v, want nil", err)
+	}
+}
+
+func TestServerInfoDeploymentID(t *testing.T) {
+	var requested string
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Query().Get("deployment")
+		json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment-a"})
+	}))
+
+	info, err := clnt.ServerInfo(t.Context(), WithDeploymentID("deployment-a"))
+	if err != nil || info.DeploymentID != "deployment-a" {
+		t.Errorf("ServerInfo() = %+v, %v", info, err)
+	}
+	if requested != "deployment-a" {
+		t.Errorf("deployment query = %q, want deployment-a", requested)
+	}
+
+	if _, err = clnt.ServerInfo(t.Context(), WithDeploymentID("deployment-b")); !errors.Is(err, ErrDeploymentMismatch) {
+		t.Errorf("ServerInfo() error = %v, want %v", err, ErrDeploymentMismatch)
+	}
+}
+
+func TestBackendTypeStringAndItemStateIsValid(t *testing.T) {
+	for b, want := range map[BackendType]string{
+		Unknown:        "Unknown",
+		FS:             "FS",
+		Erasure:        "Erasure",
+		Gateway:        "Gateway",
+		BackendType(9): "Unknown",
+	} {
+		if got := b.String(); got != want {
+			t.Errorf("BackendType(%d).String() = %q, want %q", int(b), got, want)
+		}
+	}
+	for s, want := range map[ItemState]bool{
+		ItemOnline:           true,
+		ItemOffline:          true,
+		ItemInitializing:     true,
+		ItemCordoned:         true,
+		ItemRestarting:       true,
+		ItemDraining:         true,
+		ItemState("healing"): false,
+		ItemState(""):        false,
+		ItemState("ONLINE"):  false,
+		ItemState("unknown"): false,
+	} {
+		if got := s.IsValid(); got != want {
+			t.Errorf("ItemState(%q).IsValid() = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestDataUsageInfoTopBucketsBySize(t *testing.T) {
+	d := DataUsageInfo{BucketsUsage: map[string]BucketUsageInfo{
+		"small":  {Size: 10},
+		"big":    {Size: 300},
+		"mid-b":  {Size: 100},
+		"mid-a":  {Size: 100},
+		"medium": {Size: 50},
+	}}
+	names := func(buckets []NamedBucketUsage) []string {
+		var out []string
+		for _, b := range buckets {
+			out = append(out, b.Name)
+		}
+		return out
+	}
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{n: 0, want: nil},
+		{n: 1, want: []string{"big"}},
+		{n: 3, want: []string{"big", "mid-a", "mid-b"}},
+		{n: 10, want: []string{"big", "mid-a", "mid-b", "medium", "small"}},
+	}
+	for _, tt := range tests {
+		got := d.TopBucketsBySize(tt.n)
+		if !slices.Equal(names(got), tt.want) {
+			t.Errorf("TopBucketsBySize(%d) = %v, want %v", tt.n, names(got), tt.want)
+		}
+	}
+	if got := d.TopBucketsBySize(1); got[0].Size != 300 {
+		t.Errorf("TopBucketsBySize(1)[0].Size = %d, want 300", got[0].Size)
+	}
+	if got := (DataUsageInfo{}).TopBucketsBySize(5); len(got) != 0 {
+		t.Errorf("TopBucketsBySize on empty usage = %v, want none", got)
+	}
+}
+
+func TestDataUsageInfoReplicationBacklog(t *testing.T) {
+	tests := []struct {
+		name    string
+		buckets map[string]BucketUsageInfo
+		want    ReplicationBacklog
+	}{
+		{name: "no buckets"},
+		{
+			name: "no failures",
+			buckets: map[string]BucketUsageInfo{
+				"a": {ReplicationPendingSize: 10, ReplicationPendingCount: 1},
+			},
+			want: ReplicationBacklog{PendingSize: 10, PendingCount: 1},
+		},
+		{
+			name: "worst bucket",
+			buckets: map[string]BucketUsageInfo{
+				"a": {ReplicationPendingSize: 10, ReplicationPendingCount: 1, ReplicationFailedSize: 5, ReplicationFailedCount: 1},
+				"b": {ReplicationPendingSize: 20, ReplicationPendingCount: 2, ReplicationFailedSize: 50, ReplicationFailedCount: 3},
+				"c": {ReplicationFailedSize: 50, ReplicationFailedCount: 4},
+			},
+			want: ReplicationBacklog{
+				PendingSize:           30,
+				FailedSize:            105,
+				PendingCount:          3,
+				FailedCount:           8,
+				WorstBucket:           "b",
+				WorstBucketFailedSize: 50,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DataUsageInfo{BucketsUsage: tt.buckets}.ReplicationBacklog()
+			if got != tt.want {
+				t.Errorf("ReplicationBacklog() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTimes(t *testing.T) {
+	loc := time.FixedZone("UTC+5", 5*60*60)
+	ts := time.Date(2024, 3, 1, 10, 30, 0, 0, loc)
+
+	d := DataUsageInfo{LastUpdate: ts}
+	d.NormalizeTimes()
+	if d.LastUpdate.Location() != time.UTC {
+		t.Errorf("LastUpdate location = %v, want UTC", d.LastUpdate.Location())
+	}
+	if !d.LastUpdate.Equal(ts) {
+		t.Errorf("LastUpdate = %v, want instant %v", d.LastUpdate, ts)
+	}
+
+	// A normalized snapshot must survive a msgp round trip unchanged.
+	b, err := d.MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got DataUsageInfo
+	if _, err := got.UnmarshalMsg(b); err != nil {
+		t.Fatal(err)
+	}
+	if got.LastUpdate != d.LastUpdate {
+		t.Errorf("round trip LastUpdate = %#v, want %#v", got.LastUpdate, d.LastUpdate)
+	}
+
+	g := GCStats{LastGC: ts, PauseEnd: []time.Time{ts, ts.Add(-time.Second)}}
+	g.NormalizeTimes()
+	if g.LastGC.Location() != time.UTC {
+		t.Errorf("LastGC location = %v, want UTC", g.LastGC.Location())
+	}
+	for i, pe := range g.PauseEnd {
+		if pe.Location() != time.UTC {
+			t.Errorf("PauseEnd[%d] location = %v, want UTC", i, pe.Location())
+		}
+	}
+
+	// Servers without GC stats have a nil GCStats.
+	var srv ServerProperties
+	srv.GCStats.NormalizeTimes()
+}
+
+func TestInfoMessageNodeCapacities(t *testing.T) {
+	info := InfoMessage{Servers: []ServerProperties{
+		{
+			Endpoint: "node1:9000",
+			Disks: []Disk{
+				{State: DriveStateOk, TotalSpace: 100, UsedSpace: 40, AvailableSpace: 60},
+				{State: DriveStateOk, TotalSpace: 100, UsedSpace: 10, AvailableSpace: 90},
+				{State: DriveStateOffline},
+			},
+		},
+		{
+			Endpoint: "node2:9000",
+			Disks: []Disk{
+				{State: DriveStateFaulty, TotalSpace: 50, UsedSpace: 50},
+			},
+		},
+		{Endpoint: "node3:9000"},
+	}}
+	want := map[string]NodeCapacity{
+		"node1:9000": {TotalSpace: 200, UsedSpace: 50, AvailableSpace: 150, OnlineDisks: 2, OfflineDisks: 1},
+		"node2:9000": {TotalSpace: 50, UsedSpace: 50, OfflineDisks: 1},
+		"node3:9000": {},
+	}
+	got := info.NodeCapacities()
+	if len(got) != len(want) {
+		t.Fatalf("NodeCapacities() = %+v, want %+v", got, want)
+	}
+	for endpoint, w := range want {
+		g, ok := got[endpoint]
+		if !ok {
+			t.Errorf("NodeCapacities() missing %q", endpoint)
+			continue
+		}
+		if g != w {
+			t.Errorf("NodeCapacities()[%q] = %+v, want %+v", endpoint, g, w)
+		}
+	}
+}
+
+func TestServerInfoDisallowUnknownFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		strict  bool
+		wantErr []string
+	}{
+		{name: "lenient", body: `{"mode":"online","newField":1,"servers":[{"endpoint":"a","extra":true}]}`},
+		{
+			name:    "strict unknown",
+			body:    `{"mode":"online","newField":1,"servers":[{"endpoint":"a","extra":true}]}`,
+			strict:  true,
+			wantErr: []string{`"newField"`, `"servers[0].extra"`},
+		},
+		{name: "strict known", body: `{"mode":"online","servers":[{"endpoint":"a"}]}`, strict: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				io.WriteString(w, tt.body)
+			}))
+			info, err := clnt.ServerInfo(context.Background(), WithDisallowUnknownFields(tt.strict))
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("ServerInfo() error = %v", err)
+				}
+				if info.Mode != "online" || len(info.Servers) != 1 {
+					t.Errorf("ServerInfo() = %+v, want decoded message", info)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ServerInfo() error = nil, want unknown field error")
+			}
+			for _, field := range tt.wantErr {
+				if !strings.Contains(err.Error(), field) {
+					t.Errorf("ServerInfo() error = %v, want mention of %s", err, field)
+				}
+			}
+		})
+	}
+}
+
+func TestErasureBackendStandardSCData(t *testing.T) {
+	tests := []struct {
+		name          string
+		backend       ErasureBackend
+		wantData      []int
+		wantTolerance []int
+		wantErr       bool
+	}{
+		{
+			name:          "valid",
+			backend:       ErasureBackend{StandardSCParity: 4, DrivesPerSet: []int{16, 8}},
+			wantData:      []int{12, 4},
+			wantTolerance: []int{4, 4},
+		},
+		{
+			name:          "parity not below drives",
+			backend:       ErasureBackend{StandardSCParity: 4, DrivesPerSet: []int{16, 4}},
+			wantData:      []int{12, 0},
+			wantTolerance: []int{4, 0},
+			wantErr:       true,
+		},
+		{
+			name:          "negative parity",
+			backend:       ErasureBackend{StandardSCParity: -1, DrivesPerSet: []int{4}},
+			wantData:      []int{0},
+			wantTolerance: []int{0},
+			wantErr:       true,
+		},
+		{name: "no pools", wantData: []int{}, wantTolerance: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.backend.StandardSCData(); !slices.Equal(got, tt.wantData) {
+				t.Errorf("StandardSCData() = %v, want %v", got, tt.wantData)
+			}
+			if got := tt.backend.CanToleratePoolFailures(); !slices.Equal(got, tt.wantTolerance) {
+				t.Errorf("CanToleratePoolFailures() = %v, want %v", got, tt.wantTolerance)
+			}
+			data, err := tt.backend.StandardSCDataErr()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StandardSCDataErr() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(data, tt.wantData) {
+				t.Errorf("StandardSCDataErr() = %v, want %v", data, tt.wantData)
+			}
+			tolerance, err := tt.backend.CanToleratePoolFailuresErr()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CanToleratePoolFailuresErr() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(tolerance, tt.wantTolerance) {
+				t.Errorf("CanToleratePoolFailuresErr() = %v, want %v", tolerance, tt.wantTolerance)
+			}
+		})
+	}
+}
+
+func TestServerInfoETagCache(t *testing.T) {
+	var calls, notModified atomic.Int32
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		io.WriteString(w, `{"mode":"online","deploymentID":"abc"}`)
+	}))
+
+	cache := &ServerInfoCache{}
+	info, err := clnt.ServerInfo(context.Background(), WithETagCache(cache))
+	if err != nil {
+		t.Fatalf("first ServerInfo() error = %v", err)
+	}
+	if info.DeploymentID != "abc" {
+		t.Fatalf("first ServerInfo() DeploymentID = %q, want abc", info.DeploymentID)
+	}
+
+	info, err = clnt.ServerInfo(context.Background(), WithETagCache(cache))
+	if !errors.Is(err, ErrNotModified) {
+		t.Fatalf("second ServerInfo() error = %v, want ErrNotModified", err)
+	}
+	if info.DeploymentID != "abc" || info.Mode != "online" {
+		t.Errorf("second ServerInfo() = %+v, want cached message", info)
+	}
+	if calls.Load() != 2 || notModified.Load() != 1 {
+		t.Errorf("server saw %d calls, %d not modified, want 2 and 1", calls.Load(), notModified.Load())
+	}
+
+	// Without a cache the ETag is never sent.
+	if _, err := clnt.ServerInfo(context.Background()); err != nil {
+		t.Errorf("uncached ServerInfo() error = %v", err)
+	}
+	if notModified.Load() != 1 {
+		t.Errorf("uncached ServerInfo() was revalidated")
+	}
+}
+
+func TestInfoMessageSortServers(t *testing.T) {
+	disks := func(idx ...[3]int) []Disk {
+		var out []Disk
+		for _, i := range idx {
+			out = append(out, Disk{PoolIndex: i[0], SetIndex: i[1], DiskIndex: i[2]})
+		}
+		return out
+	}
+	canonical := InfoMessage{Servers: []ServerProperties{
+		{Endpoint: "a:9000", PoolNumber: 0, Disks: disks([3]int{-1, -1, -1}, [3]int{0, 0, 0}, [3]int{0, 0, 1}, [3]int{0, 1, 0})},
+		{Endpoint: "b:9000", PoolNumber: 0, Disks: disks([3]int{0, 0, 2}, [3]int{0, 1, 1})},
+		{Endpoint: "a:9001", PoolNumber: 1, Disks: disks([3]int{1, 0, 0})},
+		{Endpoint: "c:9000", PoolNumber: 1},
+	}}
+	endpoints := func(info InfoMessage) []string {
+		var out []string
+		for _, srv := range info.Servers {
+			out = append(out, srv.Endpoint)
+		}
+		return out
+	}
+
+	for i := range 10 {
+		var info InfoMessage
+		for _, srv := range canonical.Servers {
+			srv.Disks = slices.Clone(srv.Disks)
+			rand.Shuffle(len(srv.Disks), func(i, j int) { srv.Disks[i], srv.Disks[j] = srv.Disks[j], srv.Disks[i] })
+			info.Servers = append(info.Servers, srv)
+		}
+		rand.Shuffle(len(info.Servers), func(i, j int) { info.Servers[i], info.Servers[j] = info.Servers[j], info.Servers[i] })
+
+		info.SortServers()
+		if got, want := endpoints(info), endpoints(canonical); !slices.Equal(got, want) {
+			t.Fatalf("round %d: servers = %v, want %v", i, got, want)
+		}
+		for j, srv := range info.Servers {
+			if !slices.Equal(srv.Disks, canonical.Servers[j].Disks) {
+				t.Fatalf("round %d: %s disks = %+v, want %+v", i, srv.Endpoint, srv.Disks, canonical.Servers[j].Disks)
+			}
+		}
+
+		// Sorting again must not change anything.
+		info.SortServers()
+		if got, want := endpoints(info), endpoints(canonical); !slices.Equal(got, want) {
+			t.Fatalf("round %d: re-sorted servers = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestGCStatsPauses(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	g := &GCStats{
+		Pause: []time.Duration{4 * time.Millisecond, 8 * time.Millisecond, 2 * time.Millisecond, 10 * time.Millisecond},
+		PauseEnd: []time.Time{
+			now.Add(-time.Second),
+			now.Add(-2 * time.Second),
+			now.Add(-3 * time.Second),
+			now.Add(-4 * time.Second),
+		},
+	}
+	tests := []struct {
+		window           int
+		wantMax, wantAvg time.Duration
+	}{
+		{window: 0},
+		{window: 1, wantMax: 4 * time.Millisecond, wantAvg: 4 * time.Millisecond},
+		{window: 3, wantMax: 8 * time.Millisecond, wantAvg: 14 * time.Millisecond / 3},
+		{window: 10, wantMax: 10 * time.Millisecond, wantAvg: 6 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		gotMax, gotAvg := g.RecentPauseStats(tt.window)
+		if gotMax != tt.wantMax || gotAvg != tt.wantAvg {
+			t.Errorf("RecentPauseStats(%d) = %v, %v, want %v, %v", tt.window, gotMax, gotAvg, tt.wantMax, tt.wantAvg)
+		}
+	}
+	if gotMax, gotAvg := (&GCStats{}).RecentPauseStats(5); gotMax != 0 || gotAvg != 0 {
+		t.Errorf("RecentPauseStats on empty history = %v, %v, want 0, 0", gotMax, gotAvg)
+	}
+
+	if got, want := g.PauseSince(now.Add(-2500*time.Millisecond)), 12*time.Millisecond; got != want {
+		t.Errorf("PauseSince() = %v, want %v", got, want)
+	}
+	if got := g.PauseSince(now); got != 0 {
+		t.Errorf("PauseSince(now) = %v, want 0", got)
+	}
+	var nilStats *GCStats
+	if got := nilStats.PauseSince(time.Time{}); got != 0 {
+		t.Errorf("PauseSince on nil stats = %v, want 0", got)
+	}
+}
+
+func TestMemStatsDerived(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        MemStats
+		wantLive uint64
+		wantUtil float64
+	}{
+		{name: "zero"},
+		{name: "typical", m: MemStats{Alloc: 200, HeapAlloc: 150, Mallocs: 1000, Frees: 400}, wantLive: 600, wantUtil: 0.75},
+		{name: "no alloc", m: MemStats{HeapAlloc: 150, Mallocs: 10}, wantLive: 10},
+		{name: "frees above mallocs", m: MemStats{Mallocs: 5, Frees: 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.LiveObjects(); got != tt.wantLive {
+				t.Errorf("LiveObjects() = %d, want %d", got, tt.wantLive)
+			}
+			if got := tt.m.HeapUtilization(); got != tt.wantUtil {
+				t.Errorf("HeapUtilization() = %v, want %v", got, tt.wantUtil)
+			}
+		})
+	}
+}
+
+func TestStorageInfoEndpoints(t *testing.T) {
+	s := StorageInfo{Disks: []Disk{
+		{Endpoint: "http://b:9000/d1", Local: true},
+		{Endpoint: "http://a:9000/d1"},
+		{Endpoint: ""},
+		{Endpoint: "http://b:9000/d1", Local: true},
+		{Endpoint: "http://b:9000/d2", Local: true},
+		{Endpoint: "", Local: true},
+	}}
+	if got, want := s.Endpoints(), []string{"http://a:9000/d1", "http://b:9000/d1", "http://b:9000/d2"}; !slices.Equal(got, want) {
+		t.Errorf("Endpoints() = %v, want %v", got, want)
+	}
+	if got, want := s.LocalEndpoints(), []string{"http://b:9000/d1", "http://b:9000/d2"}; !slices.Equal(got, want) {
+		t.Errorf("LocalEndpoints() = %v, want %v", got, want)
+	}
+	if got := (StorageInfo{}).Endpoints(); len(got) != 0 {
+		t.Errorf("Endpoints() on empty StorageInfo = %v, want none", got)
+	}
+}
+
+func TestStorageInfoOfflineOnly(t *testing.T) {
+	body := `{"Disks":[` +
+		`{"endpoint":"d1","state":"ok"},` +
+		`{"endpoint":"d2","state":"offline"},` +
+		`{"endpoint":"d3","state":"ok"},` +
+		`{"endpoint":"d4","state":"faulty"}],` +
+		`"Backend":{"Type":2,"OnlineDisks":{"a":2},"OfflineDisks":{"a":2}}}`
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, body)
+	}))
+	endpoints := func(s StorageInfo) []string {
+		var out []string
+		for _, d := range s.Disks {
+			out = append(out, d.Endpoint)
+		}
+		return out
+	}
+	tests := []struct {
+		name    string
+		options []func(*StorageInfoOpts)
+		want    []string
+	}{
+		{name: "default", want: []string{"d1", "d2", "d3", "d4"}},
+		{name: "disabled", options: []func(*StorageInfoOpts){WithOfflineOnly(false)}, want: []string{"d1", "d2", "d3", "d4"}},
+		{name: "offline only", options: []func(*StorageInfoOpts){WithOfflineOnly(true)}, want: []string{"d2", "d4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := clnt.StorageInfo(context.Background(), tt.options...)
+			if err != nil {
+				t.Fatalf("StorageInfo() error = %v", err)
+			}
+			if got := endpoints(info); !slices.Equal(got, tt.want) {
+				t.Errorf("StorageInfo() disks = %v, want %v", got, tt.want)
+			}
+			if info.Backend.OnlineDisks.Sum() != 2 || info.Backend.OfflineDisks.Sum() != 2 {
+				t.Errorf("StorageInfo() backend = %+v, want full cluster counts", info.Backend)
+			}
+		})
+	}
+}
+
+func TestInfoMessageLicenseExpiresIn(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	license := func(expires time.Time) *LicenseInfo {
+		return &LicenseInfo{ExpiresAt: expires}
+	}
+	tests := []struct {
+		name    string
+		servers []ServerProperties
+		want    time.Duration
+		wantOK  bool
+	}{
+		{name: "no servers"},
+		{name: "no licenses", servers: []ServerProperties{{}, {License: license(time.Time{})}}},
+		{
+			name: "earliest",
+			servers: []ServerProperties{
+				{License: license(now.Add(72 * time.Hour))},
+				{},
+				{License: license(now.Add(24 * time.Hour))},
+			},
+			want:   24 * time.Hour,
+			wantOK: true,
+		},
+		{
+			name:    "expired",
+			servers: []ServerProperties{{License: license(now.Add(-time.Hour))}},
+			want:    -time.Hour,
+			wantOK:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := InfoMessage{Servers: tt.servers}.LicenseExpiresIn(now)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("LicenseExpiresIn() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestServerInfoMulti(t *testing.T) {
+	newClient := func(status int, deploymentID string) *AdminClient {
+		return newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				io.WriteString(w, `{"Code":"AccessDenied","Message":"Access Denied."}`)
+				return
+			}
+			fmt.Fprintf(w, `{"mode":"online","deploymentID":%q}`, deploymentID)
+		}))
+	}
+	var clients []*AdminClient
+	for i := range 10 {
+		status := http.StatusOK
+		if i == 3 {
+			status = http.StatusForbidden
+		}
+		clients = append(clients, newClient(status, fmt.Sprint("cluster-", i)))
+	}
+
+	results := ServerInfoMulti(context.Background(), clients)
+	if len(results) != len(clients) {
+		t.Fatalf("ServerInfoMulti() returned %d results, want %d", len(results), len(clients))
+	}
+	for i, res := range results {
+		if want := clients[i].endpointURL.String(); res.Endpoint != want {
+			t.Errorf("result %d endpoint = %q, want %q", i, res.Endpoint, want)
+		}
+		if i == 3 {
+			if res.Err == nil {
+				t.Errorf("result %d error = nil, want access denied", i)
+			}
+			continue
+		}
+		if res.Err != nil || res.Info.DeploymentID != fmt.Sprint("cluster-", i) {
+			t.Errorf("result %d = %+v, %v, want cluster-%d", i, res.Info, res.Err, i)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i, res := range ServerInfoMulti(ctx, clients) {
+		if !errors.Is(res.Err, context.Canceled) {
+			t.Errorf("canceled result %d error = %v, want context.Canceled", i, res.Err)
+		}
+	}
+
+	if results := ServerInfoMulti(context.Background(), nil); len(results) != 0 {
+		t.Errorf("ServerInfoMulti(nil) = %v, want none", results)
+	}
+}
+
+func TestDataUsageInfoFilterBuckets(t *testing.T) {
+	d := DataUsageInfo{
+		BucketsCount:      3,
+		ObjectsTotalCount: 60,
+		ObjectsTotalSize:  600,
+		ReplicatedSize:    70,
+		TotalCapacity:     1000,
+		BucketsUsage: map[string]BucketUsageInfo{
+			"team-a-logs":  {Size: 100, ObjectsCount: 10, ReplicatedSize: 30},
+			"team-a-media": {Size: 200, ObjectsCount: 20, ReplicatedSize: 40},
+			"team-b-logs":  {Size: 300, ObjectsCount: 30},
+		},
+	}
+
+	got := d.FilterBuckets("team-a-")
+	if len(got.BucketsUsage) != 2 || got.BucketsCount != 2 {
+		t.Errorf("FilterBuckets() buckets = %v, count %d, want 2", slices.Sorted(maps.Keys(got.BucketsUsage)), got.BucketsCount)
+	}
+	if got.ObjectsTotalCount != 30 || got.ObjectsTotalSize != 300 || got.ReplicatedSize != 70 {
+		t.Errorf("FilterBuckets() totals = %d objects, %d bytes, %d replicated, want 30, 300, 70",
+			got.ObjectsTotalCount, got.ObjectsTotalSize, got.ReplicatedSize)
+	}
+	if got.TotalCapacity != 1000 {
+		t.Errorf("FilterBuckets() capacity = %d, want 1000", got.TotalCapacity)
+	}
+	if len(d.BucketsUsage) != 3 || d.BucketsCount != 3 {
+		t.Errorf("FilterBuckets() modified the receiver")
+	}
+
+	if got := d.FilterBuckets("none-"); len(got.BucketsUsage) != 0 || got.BucketsCount != 0 || got.ObjectsTotalSize != 0 {
+		t.Errorf("FilterBuckets(no match) = %+v, want no buckets", got)
+	}
+	if got := d.FilterBuckets(""); got.BucketsCount != 3 || len(got.BucketsUsage) != 3 || got.ObjectsTotalSize != 600 {
+		t.Errorf("FilterBuckets(\"\") = %+v, want unmodified copy", got)
+	}
+
+	// The maps of the result must not be shared with d.
+	d.TierStats = map[string]TierStats{"WARM": {TotalSize: 50}}
+	for _, prefix := range []string{"", "team-a-"} {
+		got := d.FilterBuckets(prefix)
+		got.BucketsUsage["team-a-new"] = BucketUsageInfo{}
+		delete(got.BucketsUsage, "team-a-logs")
+		got.TierStats["COLD"] = TierStats{}
+		if len(d.BucketsUsage) != 3 || d.BucketsUsage["team-a-logs"].Size != 100 || len(d.TierStats) != 1 {
+			t.Errorf("FilterBuckets(%q) result shares maps with the receiver", prefix)
+		}
+	}
+}
+
+func TestStorageInfoAggregateDiskMetrics(t *testing.T) {
+	s := StorageInfo{Disks: []Disk{
+		{Metrics: &DiskStatus{LastMinute: map[string]TimedAction{
+			"ReadFile":  {Count: 1, AccTime: 10, MinTime: 10, MaxTime: 10},
+			"WriteAll":  {Count: 2, AccTime: 40, MinTime: 15, MaxTime: 25},
+			"StatInfoF": {Count: 0},
+		}}},
+		{},
+		{Metrics: &DiskStatus{LastMinute: map[string]TimedAction{
+			"ReadFile": {Count: 3, AccTime: 90, MinTime: 20, MaxTime: 40},
+		}}},
+	}}
+	got := s.AggregateDiskMetrics()
+	read := got["ReadFile"]
+	if read.Count != 4 || read.AccTime != 100 || read.MinTime != 10 || read.MaxTime != 40 {
+		t.Errorf("ReadFile = %+v, want count 4, acc 100, min 10, max 40", read)
+	}
+	if avg := read.Avg(); avg != 25 {
+		t.Errorf("ReadFile average = %v, want 25ns weighted by count", avg)
+	}
+	if write := got["WriteAll"]; write.Count != 2 || write.AccTime != 40 {
+		t.Errorf("WriteAll = %+v, want count 2, acc 40", write)
+	}
+	if len(got) != 3 {
+		t.Errorf("AggregateDiskMetrics() has %d APIs, want 3", len(got))
+	}
+	if got := (StorageInfo{Disks: []Disk{{}}}).AggregateDiskMetrics(); len(got) != 0 {
+		t.Errorf("AggregateDiskMetrics() without metrics = %v, want empty", got)
+	}
+}
+
+func TestServerInfoRedactEnv(t *testing.T) {
+	body := `{"mode":"online","servers":[{"endpoint":"a","minio_env_vars":{` +
+		`"MINIO_ROOT_PASSWORD":"hunter2","MINIO_KMS_SECRET_KEY":"k","minio_identity_openid_client_secret":"s",` +
+		`"MINIO_SUBNET_API_TOKEN":"t","MINIO_REGION":"us-east-1","MINIO_CUSTOM_CERT":"c"}}]}`
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, body)
+	}))
+
+	info, err := clnt.ServerInfo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Servers[0].MinioEnvVars["MINIO_ROOT_PASSWORD"]; got != "hunter2" {
+		t.Errorf("unredacted MINIO_ROOT_PASSWORD = %q, want hunter2", got)
+	}
+
+	defer func(patterns []string) { SensitiveEnvVarPatterns = patterns }(SensitiveEnvVarPatterns)
+	SensitiveEnvVarPatterns = append(slices.Clone(SensitiveEnvVarPatterns), "cert")
+
+	info, err = clnt.ServerInfo(context.Background(), WithRedactEnv(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"MINIO_ROOT_PASSWORD":                 "***",
+		"MINIO_KMS_SECRET_KEY":                "***",
+		"minio_identity_openid_client_secret": "***",
+		"MINIO_SUBNET_API_TOKEN":              "***",
+		"MINIO_REGION":                        "us-east-1",
+		"MINIO_CUSTOM_CERT":                   "***",
+	}
+	if got := info.Servers[0].MinioEnvVars; !maps.Equal(got, want) {
+		t.Errorf("redacted env vars = %v, want %v", got, want)
+	}
+}
+
+func TestCapabilityProbes(t *testing.T) {
+	storageTests := []struct {
+		name    string
+		backend BackendInfo
+		want    bool
+	}{
+		{name: "none"},
+		{name: "sets only", backend: BackendInfo{TotalSets: []int{1}}},
+		{name: "drives only", backend: BackendInfo{DrivesPerSet: []int{4}}},
+		{name: "both", backend: BackendInfo{TotalSets: []int{1}, DrivesPerSet: []int{4}}, want: true},
+	}
+	for _, tt := range storageTests {
+		if got := (StorageInfo{Backend: tt.backend}).HasPerPoolInfo(); got != tt.want {
+			t.Errorf("%s: HasPerPoolInfo() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	infoTests := []struct {
+		name    string
+		servers []ServerProperties
+		want    bool
+	}{
+		{name: "no servers"},
+		{name: "no metrics", servers: []ServerProperties{{Disks: []Disk{{}, {}}}}},
+		{name: "some metrics", servers: []ServerProperties{{Disks: []Disk{{}}}, {Disks: []Disk{{}, {Metrics: &DiskStatus{}}}}}, want: true},
+	}
+	for _, tt := range infoTests {
+		if got := (InfoMessage{Servers: tt.servers}).HasDriveMetrics(); got != tt.want {
+			t.Errorf("%s: HasDriveMetrics() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDataUsageInfoStaleness(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		lastUpdate time.Time
+		maxAge     time.Duration
+		wantAge    time.Duration
+		wantStale  bool
+	}{
+		{name: "never updated", maxAge: time.Hour, wantStale: true},
+		{name: "fresh", lastUpdate: now.Add(-10 * time.Minute), maxAge: time.Hour, wantAge: 10 * time.Minute},
+		{name: "at max age", lastUpdate: now.Add(-time.Hour), maxAge: time.Hour, wantAge: time.Hour},
+		{name: "stale", lastUpdate: now.Add(-2 * time.Hour), maxAge: time.Hour, wantAge: 2 * time.Hour, wantStale: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DataUsageInfo{LastUpdate: tt.lastUpdate}
+			if got := d.Age(now); got != tt.wantAge {
+				t.Errorf("Age() = %v, want %v", got, tt.wantAge)
+			}
+			if got := d.IsStale(tt.maxAge, now); got != tt.wantStale {
+				t.Errorf("IsStale() = %v, want %v", got, tt.wantStale)
+			}
+		})
+	}
+}
+
+func TestInfoMessageCollectErrors(t *testing.T) {
+	var info InfoMessage
+	if got := info.CollectErrors(); len(got) != 0 {
+		t.Errorf("CollectErrors() without errors = %v, want empty", got)
+	}
+
+	err := json.Unmarshal([]byte(`{
+		"buckets": {"count": 2},
+		"objects": {"count": 0, "error": "object layer not initialized"},
+		"versions": {"count": 0, "error": "timeout"},
+		"deletemarkers": {"count": 1},
+		"usage": {"size": 0, "error": "scanner not ready"}
+	}`), &info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"objects":  "object layer not initialized",
+		"versions": "timeout",
+		"usage":    "scanner not ready",
+	}
+	if got := info.CollectErrors(); !maps.Equal(got, want) {
+		t.Errorf("CollectErrors() = %v, want %v", got, want)
+	}
+}
+
+func TestInfoMessageFlatten(t *testing.T) {
+	info := InfoMessage{
+		Mode:         "online",
+		DeploymentID: "abc",
+		Objects:      Objects{Count: 1 << 62},
+		Servers: []ServerProperties{
+			{Endpoint: "a:9000", State: "online"},
+			{
+				Endpoint: "b:9000",
+				IsLeader: true,
+				Disks:    []Disk{{State: "ok"}, {State: "ok"}, {State: "offline", TotalSpace: 100}},
+			},
+		},
+		Pools: map[int]map[int]ErasureSetInfo{
+			0: {1: {ID: 1, RawCapacity: 500}},
+		},
+	}
+	got := info.Flatten()
+	want := map[string]string{
+		"mode":                          "online",
+		"deploymentID":                  "abc",
+		"objects.count":                 "4611686018427387904",
+		"servers.0.endpoint":            "a:9000",
+		"servers.1.is_leader":           "true",
+		"servers.1.drives.2.state":      "offline",
+		"servers.1.drives.2.totalspace": "100",
+		"pools.0.1.id":                  "1",
+		"pools.0.1.rawCapacity":         "500",
+	}
+	for key, w := range want {
+		if g, ok := got[key]; !ok || g != w {
+			t.Errorf("Flatten()[%q] = %q, %v, want %q", key, g, ok, w)
+		}
+	}
+	for key := range got {
+		if strings.HasPrefix(key, "servers.0.drives") {
+			t.Errorf("Flatten() has key %q for a server without drives", key)
+		}
+	}
+}
+
+func TestDiskThroughputMBps(t *testing.T) {
+	tests := []struct {
+		name                string
+		disk                Disk
+		wantRead, wantWrite float64
+		wantTotal           float64
+	}{
+		{name: "idle"},
+		{name: "mib", disk: Disk{ReadThroughput: 1 << 20, WriteThroughPut: 3 << 19}, wantRead: 1, wantWrite: 1.5, wantTotal: 2.5},
+		{name: "not decimal", disk: Disk{ReadThroughput: 1e6}, wantRead: 1e6 / (1 << 20), wantTotal: 1e6 / (1 << 20)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.disk.ReadMBps(); got != tt.wantRead {
+				t.Errorf("ReadMBps() = %v, want %v", got, tt.wantRead)
+			}
+			if got := tt.disk.WriteMBps(); got != tt.wantWrite {
+				t.Errorf("WriteMBps() = %v, want %v", got, tt.wantWrite)
+			}
+			if got := tt.disk.TotalThroughputMBps(); got != tt.wantTotal {
+				t.Errorf("TotalThroughputMBps() = %v, want %v", got, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestCacheStatsRatios(t *testing.T) {
+	tests := []struct {
+		name                        string
+		stats                       CacheStats
+		wantHit, wantDel, wantUsage float64
+	}{
+		{name: "empty"},
+		{
+			name:      "typical",
+			stats:     CacheStats{Hits: 75, Misses: 25, DelHits: 1, DelMisses: 3, Used: 512, Capacity: 2048},
+			wantHit:   75,
+			wantDel:   25,
+			wantUsage: 25,
+		},
+		{name: "only misses", stats: CacheStats{Misses: 10, DelMisses: 10}},
+		{name: "all hits", stats: CacheStats{Hits: 10, DelHits: 4, Used: 10}, wantHit: 100, wantDel: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.HitRatio(); got != tt.wantHit {
+				t.Errorf("HitRatio() = %v, want %v", got, tt.wantHit)
+			}
+			if got := tt.stats.DelHitRatio(); got != tt.wantDel {
+				t.Errorf("DelHitRatio() = %v, want %v", got, tt.wantDel)
+			}
+			if got := tt.stats.UsedPercent(); got != tt.wantUsage {
+				t.Errorf("UsedPercent() = %v, want %v", got, tt.wantUsage)
+			}
+		})
+	}
+}
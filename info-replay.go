@@ -0,0 +1,193 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// File names of recorded info responses.
+const (
+	serverInfoRecording    = "serverinfo.json"
+	storageInfoRecording   = "storageinfo.json"
+	dataUsageInfoRecording = "datausageinfo.json"
+)
+
+// InfoClient fetches cluster information. It is implemented by
+// AdminClient, RecordingClient and ReplayClient.
+type InfoClient interface {
+	ServerInfo(ctx context.Context, options ...func(*ServerInfoOpts)) (InfoMessage, error)
+	StorageInfo(ctx context.Context, options ...func(*StorageInfoOpts)) (StorageInfo, error)
+	DataUsageInfo(ctx context.Context, options ...func(*DataUsageOpts)) (DataUsageInfo, error)
+}
+
+// RecordingClient wraps an AdminClient and writes every successful info
+// response to a directory, from where a ReplayClient can serve it back.
+//
+// Responses are recorded unfiltered: options that only trim or redact a
+// response, such as WithServerInfoHosts, WithPoolFilter, WithRedactEnv
+// or WithOfflineOnly, are not passed on to the wrapped client, but
+// applied to the recorded response before it is returned. ReplayClient
+// applies them the same way, so a recording can be replayed with any of
+// these options. All other options are passed on to the wrapped client.
+type RecordingClient struct {
+	adm *AdminClient
+	dir string
+}
+
+// NewRecordingClient returns a RecordingClient recording the responses
+// of adm into dir. The directory is created if it does not exist.
+func NewRecordingClient(adm *AdminClient, dir string) (*RecordingClient, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &RecordingClient{adm: adm, dir: dir}, nil
+}
+
+// ServerInfo calls ServerInfo on the wrapped client and records the response.
+// A cached message returned along with ErrNotModified is recorded too, and
+// passed on with the error.
+func (r *RecordingClient) ServerInfo(ctx context.Context, options ...func(*ServerInfoOpts)) (InfoMessage, error) {
+	opts := &ServerInfoOpts{}
+	for _, o := range options {
+		o(opts)
+	}
+	info, err := r.adm.ServerInfo(ctx, append(slices.Clip(options), withoutFilters)...)
+	if err != nil && !errors.Is(err, ErrNotModified) {
+		return InfoMessage{}, err
+	}
+	// Record a snapshot to preserve when and where the message was fetched.
+	if rerr := r.record(serverInfoRecording, info.Snapshot()); rerr != nil {
+		return info, rerr
+	}
+	if ferr := opts.applyFilters(&info); ferr != nil {
+		return info, ferr
+	}
+	return info, err
+}
+
+// StorageInfo calls StorageInfo on the wrapped client and records the
+// response. The disks are filtered as requested in options only after the
+// response is recorded, so that the recording holds all disks.
+func (r *RecordingClient) StorageInfo(ctx context.Context, options ...func(*StorageInfoOpts)) (StorageInfo, error) {
+	opts := &StorageInfoOpts{}
+	for _, o := range options {
+		o(opts)
+	}
+	info, err := r.adm.StorageInfo(ctx, append(slices.Clip(options), WithOfflineOnly(false))...)
+	if err != nil {
+		return StorageInfo{}, err
+	}
+	if err = r.record(storageInfoRecording, info); err != nil {
+		return StorageInfo{}, err
+	}
+	opts.filterDisks(&info)
+	return info, nil
+}
+
+// DataUsageInfo calls DataUsageInfo on the wrapped client and records the response.
+func (r *RecordingClient) DataUsageInfo(ctx context.Context, options ...func(*DataUsageOpts)) (DataUsageInfo, error) {
+	info, err := r.adm.DataUsageInfo(ctx, options...)
+	if err != nil {
+		return DataUsageInfo{}, err
+	}
+	return info, r.record(dataUsageInfoRecording, info)
+}
+
+func (r *RecordingClient) record(name string, v any) error {
+	buf, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.dir, name), buf, 0o644)
+}
+
+// ReplayClient serves info responses previously recorded by a
+// RecordingClient, without contacting any server.
+type ReplayClient struct {
+	dir string
+}
+
+// NewReplayClient returns a ReplayClient serving the responses recorded in dir.
+func NewReplayClient(dir string) *ReplayClient {
+	return &ReplayClient{dir: dir}
+}
+
+// ServerInfo returns the recorded ServerInfo response, trimmed and
+// redacted as requested in options. Other options are ignored.
+func (r *ReplayClient) ServerInfo(ctx context.Context, options ...func(*ServerInfoOpts)) (InfoMessage, error) {
+	opts := &ServerInfoOpts{}
+	for _, o := range options {
+		o(opts)
+	}
+	var snapshot InfoSnapshot
+	if err := r.replay(ctx, serverInfoRecording, &snapshot); err != nil {
+		return InfoMessage{}, err
+	}
+	info := snapshot.Message()
+	err := opts.applyFilters(&info)
+	return info, err
+}
+
+// StorageInfo returns the recorded StorageInfo response, with the disks
+// filtered as requested in options.
+func (r *ReplayClient) StorageInfo(ctx context.Context, options ...func(*StorageInfoOpts)) (StorageInfo, error) {
+	opts := &StorageInfoOpts{}
+	for _, o := range options {
+		o(opts)
+	}
+	var info StorageInfo
+	if err := r.replay(ctx, storageInfoRecording, &info); err != nil {
+		return StorageInfo{}, err
+	}
+	opts.filterDisks(&info)
+	return info, nil
+}
+
+// DataUsageInfo returns the recorded DataUsageInfo response.
+func (r *ReplayClient) DataUsageInfo(ctx context.Context, options ...func(*DataUsageOpts)) (DataUsageInfo, error) {
+	var info DataUsageInfo
+	if err := r.replay(ctx, dataUsageInfoRecording, &info); err != nil {
+		return DataUsageInfo{}, err
+	}
+	return info, nil
+}
+
+func (r *ReplayClient) replay(ctx context.Context, name string, v any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	buf, err := os.ReadFile(filepath.Join(r.dir, name))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, v)
+}
+
+var (
+	_ InfoClient = &AdminClient{}
+	_ InfoClient = &RecordingClient{}
+	_ InfoClient = &ReplayClient{}
+)
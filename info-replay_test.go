@@ -0,0 +1,209 @@
+//
+// Copyright (c) 2015-2025 MinIO, Inc.
+//
+// This file is part of MinIO Object Storage stack
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+//
+
+package madmin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/info"):
+			json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+		case strings.HasSuffix(r.URL.Path, "/storageinfo"):
+			json.NewEncoder(w).Encode(StorageInfo{Disks: []Disk{{Endpoint: "disk1"}}})
+		case strings.HasSuffix(r.URL.Path, "/datausageinfo"):
+			json.NewEncoder(w).Encode(DataUsageInfo{BucketsCount: 3})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	dir := t.TempDir()
+	rec, err := NewRecordingClient(clnt, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := rec.ServerInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rec.StorageInfo(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rec.DataUsageInfo(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	var replay InfoClient = NewReplayClient(dir)
+	replayed, err := replay.ServerInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.DeploymentID != "deployment" || replayed.Source() != info.Source() || !replayed.FetchedAt().Equal(info.FetchedAt()) {
+		t.Errorf("ServerInfo() replayed %+v, want %+v", replayed, info)
+	}
+	si, err := replay.StorageInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(si.Disks) != 1 || si.Disks[0].Endpoint != "disk1" {
+		t.Errorf("StorageInfo() replayed %+v", si)
+	}
+	du, err := replay.DataUsageInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if du.BucketsCount != 3 {
+		t.Errorf("DataUsageInfo() replayed BucketsCount = %d, want 3", du.BucketsCount)
+	}
+
+	if _, err = NewReplayClient(t.TempDir()).StorageInfo(t.Context()); err == nil {
+		t.Error("StorageInfo() returned no error without a recording")
+	}
+}
+
+func TestRecordNotModified(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		json.NewEncoder(w).Encode(InfoMessage{DeploymentID: "deployment"})
+	}))
+
+	dir := t.TempDir()
+	rec, err := NewRecordingClient(clnt, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := &ServerInfoCache{}
+	if _, err = rec.ServerInfo(t.Context(), WithETagCache(cache)); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Remove(filepath.Join(dir, serverInfoRecording)); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := rec.ServerInfo(t.Context(), WithETagCache(cache))
+	if !errors.Is(err, ErrNotModified) {
+		t.Fatalf("ServerInfo() error = %v, want ErrNotModified", err)
+	}
+	if info.DeploymentID != "deployment" {
+		t.Errorf("ServerInfo() DeploymentID = %q, want %q", info.DeploymentID, "deployment")
+	}
+	replayed, err := NewReplayClient(dir).ServerInfo(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.DeploymentID != "deployment" {
+		t.Errorf("ServerInfo() replayed DeploymentID = %q, want %q", replayed.DeploymentID, "deployment")
+	}
+}
+
+func TestRecordStorageInfoOfflineOnly(t *testing.T) {
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(StorageInfo{Disks: []Disk{
+			{Endpoint: "disk1", State: DriveStateOk},
+			{Endpoint: "disk2", State: DriveStateOffline},
+		}})
+	}))
+
+	dir := t.TempDir()
+	rec, err := NewRecordingClient(clnt, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	si, err := rec.StorageInfo(t.Context(), WithOfflineOnly(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(si.Disks) != 1 || si.Disks[0].Endpoint != "disk2" {
+		t.Errorf("StorageInfo() disks = %+v, want disk2 only", si.Disks)
+	}
+
+	replay := NewReplayClient(dir)
+	if si, err = replay.StorageInfo(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if len(si.Disks) != 2 {
+		t.Errorf("StorageInfo() replayed %d disks, want 2", len(si.Disks))
+	}
+	if si, err = replay.StorageInfo(t.Context(), WithOfflineOnly(true)); err != nil {
+		t.Fatal(err)
+	}
+	if len(si.Disks) != 1 || si.Disks[0].Endpoint != "disk2" {
+		t.Errorf("StorageInfo() replayed disks = %+v, want disk2 only", si.Disks)
+	}
+}
+
+func TestRecordServerInfoFilters(t *testing.T) {
+	var gotQuery url.Values
+	clnt := newInfoTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		json.NewEncoder(w).Encode(InfoMessage{Servers: []ServerProperties{
+			{Endpoint: "node1:9000", MinioEnvVars: map[string]string{"MINIO_ROOT_PASSWORD": "secret"}},
+			{Endpoint: "node2:9000"},
+		}})
+	}))
+
+	dir := t.TempDir()
+	rec, err := NewRecordingClient(clnt, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := rec.ServerInfo(t.Context(), WithServerInfoHosts("node1"), WithRedactEnv(true), WithDriveMetrics(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotQuery.Has("host") || gotQuery.Get("metrics") != "true" {
+		t.Errorf("query = %v, want metrics without host", gotQuery)
+	}
+	if len(info.Servers) != 1 || info.Servers[0].MinioEnvVars["MINIO_ROOT_PASSWORD"] != "***" {
+		t.Errorf("ServerInfo() servers = %+v, want node1 redacted", info.Servers)
+	}
+
+	replay := NewReplayClient(dir)
+	if info, err = replay.ServerInfo(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Servers) != 2 || info.Servers[0].MinioEnvVars["MINIO_ROOT_PASSWORD"] != "secret" {
+		t.Errorf("ServerInfo() replayed servers = %+v, want all unredacted", info.Servers)
+	}
+	if info, err = replay.ServerInfo(t.Context(), WithServerInfoHosts("node1"), WithRedactEnv(true)); err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Servers) != 1 || info.Servers[0].MinioEnvVars["MINIO_ROOT_PASSWORD"] != "***" {
+		t.Errorf("ServerInfo() replayed servers = %+v, want node1 redacted", info.Servers)
+	}
+	_, err = replay.ServerInfo(t.Context(), WithServerInfoHosts("node3"))
+	if !errors.As(err, &HostsNotFoundWarning{}) {
+		t.Errorf("ServerInfo() replayed error = %v, want HostsNotFoundWarning", err)
+	}
+}
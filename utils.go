@@ -20,6 +20,7 @@
 package madmin
 
 import (
+	"compress/gzip"
 	"io"
 	"net"
 	"net/http"
@@ -144,6 +145,24 @@ func closeResponse(resp *http.Response) {
 	}
 }
 
+// acceptGzipHeaders returns request headers advertising support for
+// gzip encoded responses, to be decoded with responseReader.
+func acceptGzipHeaders() http.Header {
+	h := make(http.Header)
+	h.Set("Accept-Encoding", "gzip")
+	return h
+}
+
+// responseReader returns a reader over the response body, decompressing
+// it if the server sent it gzip encoded. The returned reader does not
+// need to be closed, closeResponse still drains and closes the body.
+func responseReader(resp *http.Response) (io.Reader, error) {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp.Body, nil
+	}
+	return gzip.NewReader(resp.Body)
+}
+
 // TimedAction contains a number of actions and their accumulated duration in nanoseconds.
 type TimedAction struct {
 	Count   uint64 `json:"count"`